@@ -0,0 +1,279 @@
+package datasource_cloud_cost_dashboard
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// FilterAttributes are the date range and filters shared by the cloud cost
+// data sources.
+func FilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"organization": schema.StringAttribute{
+			Description:         "The organization canonical. Defaults to the provider's `default_organization`.",
+			MarkdownDescription: "The organization canonical. Defaults to the provider's `default_organization`.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"begin": schema.StringAttribute{
+			Description:         "The first day of the period, as `YYYY-MM-DD`.",
+			MarkdownDescription: "The first day of the period, as `YYYY-MM-DD`.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.RegexMatches(dateRegex, "must be a date as YYYY-MM-DD")},
+		},
+		"end": schema.StringAttribute{
+			Description:         "The last day of the period, as `YYYY-MM-DD`.",
+			MarkdownDescription: "The last day of the period, as `YYYY-MM-DD`.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.RegexMatches(dateRegex, "must be a date as YYYY-MM-DD")},
+		},
+		"granularity": schema.StringAttribute{
+			Description:         "The size of the histogram buckets over time, `day` or `month`. Defaults to `month`.",
+			MarkdownDescription: "The size of the histogram buckets over time, `day` or `month`. Defaults to `month`.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.OneOf("day", "month")},
+		},
+		"providers": schema.ListAttribute{
+			Description:         "Only include the costs of these cloud providers.",
+			MarkdownDescription: "Only include the costs of these cloud providers.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"projects": schema.ListAttribute{
+			Description:         "Only include the costs of these project canonicals.",
+			MarkdownDescription: "Only include the costs of these project canonicals.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"tags": schema.MapAttribute{
+			Description:         "Only include the costs of the cloud resources having these tag values, keyed by tag key.",
+			MarkdownDescription: "Only include the costs of the cloud resources having these tag values, keyed by tag key.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+func bucketAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"value": schema.StringAttribute{
+			Description:         "The value aggregated on, like a date, a provider or a project.",
+			MarkdownDescription: "The value aggregated on, like a date, a provider or a project.",
+			Computed:            true,
+		},
+		"cost": schema.Float64Attribute{
+			Description:         "The cost.",
+			MarkdownDescription: "The cost.",
+			Computed:            true,
+		},
+		"co2e": schema.Float64Attribute{
+			Description:         "The CO2e emissions, in metric tons.",
+			MarkdownDescription: "The CO2e emissions, in metric tons.",
+			Computed:            true,
+		},
+		"kwh": schema.Float64Attribute{
+			Description:         "The energy consumption, in kWh.",
+			MarkdownDescription: "The energy consumption, in kWh.",
+			Computed:            true,
+		},
+	}
+}
+
+// HistogramAttribute is a cost histogram, its buckets are split in sub
+// buckets, like the months of the period split by project.
+func HistogramAttribute(description string) schema.SingleNestedAttribute {
+	buckets := bucketAttributes()
+	buckets["buckets"] = schema.ListNestedAttribute{
+		Description:         "The sub buckets of the bucket.",
+		MarkdownDescription: "The sub buckets of the bucket.",
+		Computed:            true,
+		NestedObject:        schema.NestedAttributeObject{Attributes: bucketAttributes()},
+	}
+
+	histogram := bucketAttributes()
+	delete(histogram, "value")
+	histogram["buckets"] = schema.ListNestedAttribute{
+		Description:         "The buckets of the histogram.",
+		MarkdownDescription: "The buckets of the histogram.",
+		Computed:            true,
+		NestedObject:        schema.NestedAttributeObject{Attributes: buckets},
+	}
+
+	return schema.SingleNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes:          histogram,
+	}
+}
+
+func CloudCostDashboardDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := FilterAttributes()
+	attributes["total_cost"] = schema.Float64Attribute{
+		Description:         "The total cost of the period.",
+		MarkdownDescription: "The total cost of the period.",
+		Computed:            true,
+	}
+	attributes["total_co2e"] = schema.Float64Attribute{
+		Description:         "The total CO2e emissions of the period, in metric tons.",
+		MarkdownDescription: "The total CO2e emissions of the period, in metric tons.",
+		Computed:            true,
+	}
+	attributes["total_kwh"] = schema.Float64Attribute{
+		Description:         "The total energy consumption of the period, in kWh.",
+		MarkdownDescription: "The total energy consumption of the period, in kWh.",
+		Computed:            true,
+	}
+	attributes["providers_histogram"] = HistogramAttribute("The costs over time, split by cloud provider.")
+	attributes["projects_histogram"] = HistogramAttribute("The costs over time, split by project.")
+	attributes["project_costs"] = schema.ListNestedAttribute{
+		Description:         "The cost of each project over the period, with the resources it uses on each cloud provider.",
+		MarkdownDescription: "The cost of each project over the period, with the resources it uses on each cloud provider.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"project": schema.StringAttribute{
+					Description:         "The project canonical.",
+					MarkdownDescription: "The project canonical.",
+					Computed:            true,
+				},
+				"cost": schema.Float64Attribute{
+					Description:         "The cost of the project.",
+					MarkdownDescription: "The cost of the project.",
+					Computed:            true,
+				},
+				"providers": schema.ListNestedAttribute{
+					Description:         "The costs of the project per cloud provider.",
+					MarkdownDescription: "The costs of the project per cloud provider.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"provider":      schema.StringAttribute{Computed: true},
+							"cost":          schema.Float64Attribute{Computed: true},
+							"co2e":          schema.Float64Attribute{Computed: true},
+							"kwh":           schema.Float64Attribute{Computed: true},
+							"resources":     schema.Int64Attribute{Computed: true},
+							"new_resources": schema.Int64Attribute{Computed: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	attributes["filter_values"] = schema.SingleNestedAttribute{
+		Description:         "The values available to filter on over the period.",
+		MarkdownDescription: "The values available to filter on over the period.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"providers":       schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"projects":        schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"environments":    schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"components":      schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"regions":         schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"services":        schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"linked_accounts": schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"currencies":      schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			"tags": schema.MapAttribute{
+				Description:         "The values of each tag key.",
+				MarkdownDescription: "The values of each tag key.",
+				Computed:            true,
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	return schema.Schema{
+		Description:         "Reads the Cloud Cost Management dashboard of an organization: the total cost, CO2e emissions and energy consumption over a period, split by cloud provider and by project.",
+		MarkdownDescription: "Reads the Cloud Cost Management dashboard of an organization: the total cost, CO2e emissions and energy consumption over a period, split by cloud provider and by project.",
+		Attributes:          attributes,
+	}
+}
+
+// FilterModel holds the attributes of FilterAttributes.
+type FilterModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Begin        types.String `tfsdk:"begin"`
+	End          types.String `tfsdk:"end"`
+	Granularity  types.String `tfsdk:"granularity"`
+	Providers    types.List   `tfsdk:"providers"`
+	Projects     types.List   `tfsdk:"projects"`
+	Tags         types.Map    `tfsdk:"tags"`
+}
+
+type CloudCostDashboardModel struct {
+	FilterModel
+
+	TotalCost          types.Float64 `tfsdk:"total_cost"`
+	TotalCo2e          types.Float64 `tfsdk:"total_co2e"`
+	TotalKwh           types.Float64 `tfsdk:"total_kwh"`
+	ProvidersHistogram types.Object  `tfsdk:"providers_histogram"`
+	ProjectsHistogram  types.Object  `tfsdk:"projects_histogram"`
+	ProjectCosts       types.List    `tfsdk:"project_costs"`
+	FilterValues       types.Object  `tfsdk:"filter_values"`
+}
+
+func SubBucketAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"value": types.StringType,
+		"cost":  types.Float64Type,
+		"co2e":  types.Float64Type,
+		"kwh":   types.Float64Type,
+	}
+}
+
+func BucketAttrTypes() map[string]attr.Type {
+	t := SubBucketAttrTypes()
+	t["buckets"] = types.ListType{ElemType: types.ObjectType{AttrTypes: SubBucketAttrTypes()}}
+	return t
+}
+
+func HistogramAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cost":    types.Float64Type,
+		"co2e":    types.Float64Type,
+		"kwh":     types.Float64Type,
+		"buckets": types.ListType{ElemType: types.ObjectType{AttrTypes: BucketAttrTypes()}},
+	}
+}
+
+func ProjectProviderCostAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider":      types.StringType,
+		"cost":          types.Float64Type,
+		"co2e":          types.Float64Type,
+		"kwh":           types.Float64Type,
+		"resources":     types.Int64Type,
+		"new_resources": types.Int64Type,
+	}
+}
+
+func ProjectCostAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"project":   types.StringType,
+		"cost":      types.Float64Type,
+		"providers": types.ListType{ElemType: types.ObjectType{AttrTypes: ProjectProviderCostAttrTypes()}},
+	}
+}
+
+func FilterValuesAttrTypes() map[string]attr.Type {
+	list := types.ListType{ElemType: types.StringType}
+	return map[string]attr.Type{
+		"providers":       list,
+		"projects":        list,
+		"environments":    list,
+		"components":      list,
+		"regions":         list,
+		"services":        list,
+		"linked_accounts": list,
+		"currencies":      list,
+		"tags":            types.MapType{ElemType: list},
+	}
+}
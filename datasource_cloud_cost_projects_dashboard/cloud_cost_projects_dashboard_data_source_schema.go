@@ -0,0 +1,29 @@
+package datasource_cloud_cost_projects_dashboard
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_cloud_cost_dashboard"
+)
+
+func CloudCostProjectsDashboardDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := datasource_cloud_cost_dashboard.FilterAttributes()
+	attributes["projects_histogram"] = datasource_cloud_cost_dashboard.HistogramAttribute("The costs over time, split by project.")
+	attributes["project_providers_histogram"] = datasource_cloud_cost_dashboard.HistogramAttribute("The costs of each project, split by cloud provider.")
+
+	return schema.Schema{
+		Description:         "Reads the projects Cloud Cost Management dashboard of an organization: the cost of each project over a period, split over time and by cloud provider.",
+		MarkdownDescription: "Reads the projects Cloud Cost Management dashboard of an organization: the cost of each project over a period, split over time and by cloud provider.",
+		Attributes:          attributes,
+	}
+}
+
+type CloudCostProjectsDashboardModel struct {
+	datasource_cloud_cost_dashboard.FilterModel
+
+	ProjectsHistogram         types.Object `tfsdk:"projects_histogram"`
+	ProjectProvidersHistogram types.Object `tfsdk:"project_providers_histogram"`
+}
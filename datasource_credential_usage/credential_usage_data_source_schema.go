@@ -0,0 +1,136 @@
+package datasource_credential_usage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CredentialUsageDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the objects using a credential: config repositories, catalog repositories, cloud accounts and external backends. A credential still in use cannot be deleted.",
+		MarkdownDescription: "Lists the objects using a credential: config repositories, catalog repositories, cloud accounts and external backends. A credential still in use cannot be deleted.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the credential. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical of the credential. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"canonical": schema.StringAttribute{
+				Description:         "The canonical of the credential.",
+				MarkdownDescription: "The canonical of the credential.",
+				Required:            true,
+			},
+			"in_use": schema.BoolAttribute{
+				Description:         "Whether any object uses the credential.",
+				MarkdownDescription: "Whether any object uses the credential.",
+				Computed:            true,
+			},
+			"config_repositories": schema.ListNestedAttribute{
+				Description:         "The config repositories using the credential.",
+				MarkdownDescription: "The config repositories using the credential.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"canonical": schema.StringAttribute{Computed: true},
+						"name":      schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"catalog_repositories": schema.ListNestedAttribute{
+				Description:         "The catalog repositories using the credential.",
+				MarkdownDescription: "The catalog repositories using the credential.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"canonical": schema.StringAttribute{Computed: true},
+						"name":      schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"cloud_accounts": schema.ListNestedAttribute{
+				Description:         "The cloud accounts using the credential.",
+				MarkdownDescription: "The cloud accounts using the credential.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"canonical":      schema.StringAttribute{Computed: true},
+						"name":           schema.StringAttribute{Computed: true},
+						"cloud_provider": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"external_backends": schema.ListNestedAttribute{
+				Description:         "The external backends using the credential, with the project, environment and component they are attached to, if any.",
+				MarkdownDescription: "The external backends using the credential, with the project, environment and component they are attached to, if any.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"engine":      schema.StringAttribute{Computed: true},
+						"purpose":     schema.StringAttribute{Computed: true},
+						"project":     schema.StringAttribute{Computed: true},
+						"environment": schema.StringAttribute{Computed: true},
+						"component":   schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+type CredentialUsageModel struct {
+	Organization        types.String `tfsdk:"organization"`
+	Canonical           types.String `tfsdk:"canonical"`
+	InUse               types.Bool   `tfsdk:"in_use"`
+	ConfigRepositories  types.List   `tfsdk:"config_repositories"`
+	CatalogRepositories types.List   `tfsdk:"catalog_repositories"`
+	CloudAccounts       types.List   `tfsdk:"cloud_accounts"`
+	ExternalBackends    types.List   `tfsdk:"external_backends"`
+}
+
+type RepositoryItem struct {
+	Canonical types.String `tfsdk:"canonical"`
+	Name      types.String `tfsdk:"name"`
+}
+
+func RepositoryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"canonical": types.StringType,
+		"name":      types.StringType,
+	}
+}
+
+type CloudAccountItem struct {
+	Canonical     types.String `tfsdk:"canonical"`
+	Name          types.String `tfsdk:"name"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+}
+
+func CloudAccountAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"canonical":      types.StringType,
+		"name":           types.StringType,
+		"cloud_provider": types.StringType,
+	}
+}
+
+type ExternalBackendItem struct {
+	Engine      types.String `tfsdk:"engine"`
+	Purpose     types.String `tfsdk:"purpose"`
+	Project     types.String `tfsdk:"project"`
+	Environment types.String `tfsdk:"environment"`
+	Component   types.String `tfsdk:"component"`
+}
+
+func ExternalBackendAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"engine":      types.StringType,
+		"purpose":     types.StringType,
+		"project":     types.StringType,
+		"environment": types.StringType,
+		"component":   types.StringType,
+	}
+}
@@ -0,0 +1,41 @@
+package datasource_external_backend
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_external_backends"
+)
+
+func ExternalBackendDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := datasource_external_backends.ExternalBackendAttributes()
+	for name, a := range datasource_external_backends.FilterAttributes(true) {
+		attributes[name] = a
+	}
+	attributes["organization"] = schema.StringAttribute{
+		Description:         "The organization canonical of the external backend. Defaults to the provider's `default_organization`.",
+		MarkdownDescription: "The organization canonical of the external backend. Defaults to the provider's `default_organization`.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["external_backend_id"] = schema.Int64Attribute{
+		Description:         "The ID of the external backend. When not set, the filters must match exactly one external backend.",
+		MarkdownDescription: "The ID of the external backend. When not set, the filters must match exactly one external backend.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	return schema.Schema{
+		Description:         "Reads an external backend, by ID or by the filters which must then match exactly one external backend.",
+		MarkdownDescription: "Reads an external backend, by ID or by the filters which must then match exactly one external backend.",
+		Attributes:          attributes,
+	}
+}
+
+type ExternalBackendModel struct {
+	datasource_external_backends.ExternalBackendModel
+
+	Organization types.String `tfsdk:"organization"`
+}
@@ -0,0 +1,147 @@
+package datasource_external_backends
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Purposes are the purposes an external backend can have.
+var Purposes = []string{"events", "logs", "remote_tfstate", "cost_explorer"}
+
+// FilterAttributes are the attributes external backends can be filtered on,
+// computed and optional when optional is set.
+func FilterAttributes(optional bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"purpose": schema.StringAttribute{
+			Description:         "The purpose of the external backend: `events`, `logs`, `remote_tfstate` or `cost_explorer`.",
+			MarkdownDescription: "The purpose of the external backend: `events`, `logs`, `remote_tfstate` or `cost_explorer`.",
+		},
+		"engine": schema.StringAttribute{
+			Description:         "The engine of the external backend, like `aws_storage` or `gcp_remote_tf_state`.",
+			MarkdownDescription: "The engine of the external backend, like `aws_storage` or `gcp_remote_tf_state`.",
+		},
+		"project_canonical": schema.StringAttribute{
+			Description:         "The canonical of the project the external backend is scoped to.",
+			MarkdownDescription: "The canonical of the project the external backend is scoped to.",
+		},
+		"environment_canonical": schema.StringAttribute{
+			Description:         "The canonical of the environment the external backend is scoped to.",
+			MarkdownDescription: "The canonical of the environment the external backend is scoped to.",
+		},
+		"component_canonical": schema.StringAttribute{
+			Description:         "The canonical of the component the external backend is scoped to.",
+			MarkdownDescription: "The canonical of the component the external backend is scoped to.",
+		},
+		"default": schema.BoolAttribute{
+			Description:         "Whether the external backend is the default one of the organization for its purpose.",
+			MarkdownDescription: "Whether the external backend is the default one of the organization for its purpose.",
+		},
+	}
+
+	for name, a := range attributes {
+		switch a := a.(type) {
+		case schema.StringAttribute:
+			a.Optional, a.Computed = optional, true
+			if name == "purpose" && optional {
+				a.Validators = []validator.String{stringvalidator.OneOf(Purposes...)}
+			}
+			attributes[name] = a
+		case schema.BoolAttribute:
+			a.Optional, a.Computed = optional, true
+			attributes[name] = a
+		}
+	}
+
+	return attributes
+}
+
+// ExternalBackendAttributes are the attributes of an external backend.
+func ExternalBackendAttributes() map[string]schema.Attribute {
+	attributes := FilterAttributes(false)
+	attributes["external_backend_id"] = schema.Int64Attribute{
+		Description:         "The ID of the external backend.",
+		MarkdownDescription: "The ID of the external backend.",
+		Computed:            true,
+	}
+	attributes["credential_canonical"] = schema.StringAttribute{
+		Description:         "The canonical of the credential used by the external backend.",
+		MarkdownDescription: "The canonical of the credential used by the external backend.",
+		Computed:            true,
+	}
+	attributes["configuration"] = schema.MapAttribute{
+		Description:         "The configuration of the engine, like the bucket and region of an `aws_storage`. Values which are not strings are JSON encoded.",
+		MarkdownDescription: "The configuration of the engine, like the bucket and region of an `aws_storage`. Values which are not strings are JSON encoded.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	return attributes
+}
+
+func ExternalBackendsDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := FilterAttributes(true)
+	attributes["organization"] = schema.StringAttribute{
+		Description:         "The organization canonical of the external backends. Defaults to the provider's `default_organization`.",
+		MarkdownDescription: "The organization canonical of the external backends. Defaults to the provider's `default_organization`.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["external_backends"] = schema.ListNestedAttribute{
+		Description:         "The external backends matching all the filters set.",
+		MarkdownDescription: "The external backends matching all the filters set.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ExternalBackendAttributes(),
+		},
+	}
+
+	return schema.Schema{
+		Description:         "Lists the external backends of an organization, optionally filtered on their purpose, engine, scope or default flag.",
+		MarkdownDescription: "Lists the external backends of an organization, optionally filtered on their purpose, engine, scope or default flag.",
+		Attributes:          attributes,
+	}
+}
+
+// FilterModel holds the attributes of FilterAttributes.
+type FilterModel struct {
+	Purpose              types.String `tfsdk:"purpose"`
+	Engine               types.String `tfsdk:"engine"`
+	ProjectCanonical     types.String `tfsdk:"project_canonical"`
+	EnvironmentCanonical types.String `tfsdk:"environment_canonical"`
+	ComponentCanonical   types.String `tfsdk:"component_canonical"`
+	Default              types.Bool   `tfsdk:"default"`
+}
+
+// ExternalBackendModel holds the attributes of ExternalBackendAttributes.
+type ExternalBackendModel struct {
+	FilterModel
+
+	ExternalBackendID   types.Int64  `tfsdk:"external_backend_id"`
+	CredentialCanonical types.String `tfsdk:"credential_canonical"`
+	Configuration       types.Map    `tfsdk:"configuration"`
+}
+
+type ExternalBackendsModel struct {
+	FilterModel
+
+	Organization     types.String `tfsdk:"organization"`
+	ExternalBackends types.List   `tfsdk:"external_backends"`
+}
+
+func ExternalBackendAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"purpose":               types.StringType,
+		"engine":                types.StringType,
+		"project_canonical":     types.StringType,
+		"environment_canonical": types.StringType,
+		"component_canonical":   types.StringType,
+		"default":               types.BoolType,
+		"external_backend_id":   types.Int64Type,
+		"credential_canonical":  types.StringType,
+		"configuration":         types.MapType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,107 @@
+package datasource_oidc_group_mappings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+func OidcGroupMappingsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the OIDC group mappings of an organization, optionally filtered with LHS filters, to audit which teams and roles each OIDC group grants.",
+		MarkdownDescription: "Lists the OIDC group mappings of an organization, optionally filtered with LHS filters, to audit which teams and roles each OIDC group grants.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the mappings. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical of the mappings. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"filters": lhsfilter.Attribute("the OIDC group mappings", "canonical", ""),
+			"mappings": schema.ListNestedAttribute{
+				Description:         "The OIDC group mappings matching the filters.",
+				MarkdownDescription: "The OIDC group mappings matching the `filters`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "OIDC group mapping identifier.",
+							MarkdownDescription: "OIDC group mapping identifier.",
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							Description:         "The OIDC group claim value matched.",
+							MarkdownDescription: "The OIDC group claim value matched.",
+							Computed:            true,
+						},
+						"team_canonical": schema.StringAttribute{
+							Description:         "The canonical of the team the user is added to, null when the team was deleted.",
+							MarkdownDescription: "The canonical of the team the user is added to, null when the team was deleted.",
+							Computed:            true,
+						},
+						"team_name": schema.StringAttribute{
+							Description:         "The name of the team the user is added to.",
+							MarkdownDescription: "The name of the team the user is added to.",
+							Computed:            true,
+						},
+						"team_roles": schema.ListAttribute{
+							Description:         "The canonicals of the roles of the team, granted to the users of the group.",
+							MarkdownDescription: "The canonicals of the roles of the team, granted to the users of the group.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"created_at": schema.Int64Attribute{
+							Description:         "Creation date of the mapping, as a unix timestamp.",
+							MarkdownDescription: "Creation date of the mapping, as a unix timestamp.",
+							Computed:            true,
+						},
+						"updated_at": schema.Int64Attribute{
+							Description:         "Last update date of the mapping, as a unix timestamp.",
+							MarkdownDescription: "Last update date of the mapping, as a unix timestamp.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"groups": schema.MapAttribute{
+				Description:         "The canonicals of the teams of the mappings matching the filters, by OIDC group claim value. Same format as the mappings of the cycloid_oidc_group_mappings resource.",
+				MarkdownDescription: "The canonicals of the teams of the mappings matching the `filters`, by OIDC group claim value. Same format as the `mappings` of the `cycloid_oidc_group_mappings` resource.",
+				Computed:            true,
+				ElementType:         types.SetType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+type OidcGroupMappingsModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Filters      types.List   `tfsdk:"filters"`
+	Mappings     types.List   `tfsdk:"mappings"`
+	Groups       types.Map    `tfsdk:"groups"`
+}
+
+type MappingModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	GroupName     types.String `tfsdk:"group_name"`
+	TeamCanonical types.String `tfsdk:"team_canonical"`
+	TeamName      types.String `tfsdk:"team_name"`
+	TeamRoles     types.List   `tfsdk:"team_roles"`
+	CreatedAt     types.Int64  `tfsdk:"created_at"`
+	UpdatedAt     types.Int64  `tfsdk:"updated_at"`
+}
+
+func MappingAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.Int64Type,
+		"group_name":     types.StringType,
+		"team_canonical": types.StringType,
+		"team_name":      types.StringType,
+		"team_roles":     types.ListType{ElemType: types.StringType},
+		"created_at":     types.Int64Type,
+		"updated_at":     types.Int64Type,
+	}
+}
@@ -0,0 +1,116 @@
+package datasource_organization_invitations
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func OrganizationInvitationsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the invitations of an organization which have not been accepted yet. Emails are stored in Terraform state — protect your state backend accordingly.",
+		MarkdownDescription: "Lists the invitations of an organization which have not been accepted yet. Emails are stored in Terraform state — protect your state backend accordingly.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the invitations. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical of the invitations. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"invitation_validity_days": schema.Int64Attribute{
+				Description:         "How many days an invitation can be accepted after it was last sent. The API does not expose the expiry of the invitations, so it must match the validity period of your Cycloid instance. Without it, no invitation is expired and expires_at is null.",
+				MarkdownDescription: "How many days an invitation can be accepted after it was last sent. The API does not expose the expiry of the invitations, so it must match the validity period of your Cycloid instance. Without it, no invitation is `expired` and `expires_at` is null.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description:         "Only return the invitations with this status, pending or expired.",
+				MarkdownDescription: "Only return the invitations with this status, `pending` or `expired`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "expired"),
+				},
+			},
+			"invitations": schema.ListNestedAttribute{
+				Description:         "The invitations not accepted yet.",
+				MarkdownDescription: "The invitations not accepted yet.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.Int64Attribute{
+							Description:         "The ID of the invited member.",
+							MarkdownDescription: "The ID of the invited member.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							Description:         "The email the invitation was sent to.",
+							MarkdownDescription: "The email the invitation was sent to.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							Description:         "The canonical of the role of the invited member.",
+							MarkdownDescription: "The canonical of the role of the invited member.",
+							Computed:            true,
+						},
+						"invited_by": schema.StringAttribute{
+							Description:         "The username of the member who sent the invitation.",
+							MarkdownDescription: "The username of the member who sent the invitation.",
+							Computed:            true,
+						},
+						"sent_at": schema.StringAttribute{
+							Description:         "When the invitation was last sent, in RFC 3339 format.",
+							MarkdownDescription: "When the invitation was last sent, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description:         "When the invitation expires, in RFC 3339 format. Null when invitation_validity_days is not set.",
+							MarkdownDescription: "When the invitation expires, in RFC 3339 format. Null when `invitation_validity_days` is not set.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "The status of the invitation, pending or expired.",
+							MarkdownDescription: "The status of the invitation, `pending` or `expired`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type OrganizationInvitationsModel struct {
+	Organization           types.String `tfsdk:"organization"`
+	InvitationValidityDays types.Int64  `tfsdk:"invitation_validity_days"`
+	Status                 types.String `tfsdk:"status"`
+	Invitations            types.List   `tfsdk:"invitations"`
+}
+
+type InvitationModel struct {
+	MemberID  types.Int64  `tfsdk:"member_id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	InvitedBy types.String `tfsdk:"invited_by"`
+	SentAt    types.String `tfsdk:"sent_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Status    types.String `tfsdk:"status"`
+}
+
+func InvitationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"member_id":  types.Int64Type,
+		"email":      types.StringType,
+		"role":       types.StringType,
+		"invited_by": types.StringType,
+		"sent_at":    types.StringType,
+		"expires_at": types.StringType,
+		"status":     types.StringType,
+	}
+}
@@ -0,0 +1,37 @@
+package datasource_organization_role
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_organization_roles"
+)
+
+func OrganizationRoleDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := datasource_organization_roles.RoleAttributes()
+	attributes["organization"] = schema.StringAttribute{
+		Description:         "The organization canonical of the role. Defaults to the provider's `default_organization`.",
+		MarkdownDescription: "The organization canonical of the role. Defaults to the provider's `default_organization`.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["canonical"] = schema.StringAttribute{
+		Description:         "The canonical of the role.",
+		MarkdownDescription: "The canonical of the role.",
+		Required:            true,
+	}
+
+	return schema.Schema{
+		Description:         "Reads a role of an organization by its canonical, with its rules.",
+		MarkdownDescription: "Reads a role of an organization by its canonical, with its rules.",
+		Attributes:          attributes,
+	}
+}
+
+type OrganizationRoleModel struct {
+	datasource_organization_roles.RoleModel
+
+	Organization types.String `tfsdk:"organization"`
+}
@@ -0,0 +1,102 @@
+package datasource_organization_roles
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_policy_document"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+// RoleAttributes are the attributes of a role.
+func RoleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"canonical": schema.StringAttribute{
+			Description:         "The canonical of the role.",
+			MarkdownDescription: "The canonical of the role.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the role.",
+			MarkdownDescription: "The name of the role.",
+			Computed:            true,
+		},
+		"role_id": schema.Int64Attribute{
+			Description:         "The ID of the role.",
+			MarkdownDescription: "The ID of the role.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "The description of the role.",
+			MarkdownDescription: "The description of the role.",
+			Computed:            true,
+		},
+		"default": schema.BoolAttribute{
+			Description:         "Whether the role is a default role of Cycloid.",
+			MarkdownDescription: "Whether the role is a default role of Cycloid.",
+			Computed:            true,
+		},
+		"rules": schema.ListNestedAttribute{
+			Description:         "The rules of the role, which can be passed to the source_rules of a cycloid_policy_document.",
+			MarkdownDescription: "The rules of the role, which can be passed to the `source_rules` of a `cycloid_policy_document`.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: datasource_policy_document.RuleAttributes(true),
+			},
+		},
+	}
+}
+
+func OrganizationRolesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the roles of an organization, optionally filtered with LHS filters.",
+		MarkdownDescription: "Lists the roles of an organization, optionally filtered with LHS filters.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the roles. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical of the roles. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"filters": lhsfilter.Attribute("roles", "canonical", ""),
+			"roles": schema.ListNestedAttribute{
+				Description:         "The roles matching the filters.",
+				MarkdownDescription: "The roles matching the `filters`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: RoleAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// RoleModel holds the attributes of RoleAttributes.
+type RoleModel struct {
+	Canonical   types.String `tfsdk:"canonical"`
+	Name        types.String `tfsdk:"name"`
+	RoleID      types.Int64  `tfsdk:"role_id"`
+	Description types.String `tfsdk:"description"`
+	Default     types.Bool   `tfsdk:"default"`
+	Rules       types.List   `tfsdk:"rules"`
+}
+
+type OrganizationRolesModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Filters      types.List   `tfsdk:"filters"`
+	Roles        types.List   `tfsdk:"roles"`
+}
+
+func RoleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"canonical":   types.StringType,
+		"name":        types.StringType,
+		"role_id":     types.Int64Type,
+		"description": types.StringType,
+		"default":     types.BoolType,
+		"rules":       types.ListType{ElemType: types.ObjectType{AttrTypes: datasource_policy_document.RuleAttrTypes()}},
+	}
+}
@@ -0,0 +1,112 @@
+package datasource_permission_check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func PermissionCheckDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Checks whether actions are allowed, for the API key of the provider or for a member of the organization. Useful in preconditions, to assert an automation has the rights it needs before changing anything, and to test role definitions.",
+		MarkdownDescription: "Checks whether actions are allowed, for the API key of the provider or for a member of the organization. Useful in preconditions, to assert an automation has the rights it needs before changing anything, and to test role definitions.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical to check the actions in. Defaults to the provider's default_organization.",
+				MarkdownDescription: "The organization canonical to check the actions in. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"member_id": schema.Int64Attribute{
+				Description:         "The ID of the member to check the actions for, instead of the API key of the provider. The API cannot check the actions of another member, they are evaluated by the provider against the allow rules of the role of the member and of the roles of their teams, so the results are approximate.",
+				MarkdownDescription: "The ID of the member to check the actions for, instead of the API key of the provider. The API cannot check the actions of another member, they are evaluated by the provider against the allow rules of the role of the member and of the roles of their teams, so the results are approximate.",
+				Optional:            true,
+			},
+			"checks": schema.ListNestedAttribute{
+				Description:         "The actions to check.",
+				MarkdownDescription: "The actions to check.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description:         "The action to check, like organization:environment:update.",
+							MarkdownDescription: "The action to check, like `organization:environment:update`.",
+							Required:            true,
+						},
+						"entity_canonicals": schema.ListAttribute{
+							Description:         "The canonicals of the entity the action applies to and of the entities above it, excluding the organization, like [\"my-project\", \"prod\"] for an environment. Omit to check the action on the organization, it must be omitted for the actions on the organization itself like organization:read.",
+							MarkdownDescription: "The canonicals of the entity the action applies to and of the entities above it, excluding the organization, like `[\"my-project\", \"prod\"]` for an environment. Omit to check the action on the organization, it must be omitted for the actions on the organization itself like `organization:read`.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description:         "The result of each check, in the order of checks.",
+				MarkdownDescription: "The result of each check, in the order of `checks`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description:         "The action checked.",
+							MarkdownDescription: "The action checked.",
+							Computed:            true,
+						},
+						"entity_canonicals": schema.ListAttribute{
+							Description:         "The canonicals of the entity checked.",
+							MarkdownDescription: "The canonicals of the entity checked.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"allowed": schema.BoolAttribute{
+							Description:         "Whether the action is allowed.",
+							MarkdownDescription: "Whether the action is allowed.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"all_allowed": schema.BoolAttribute{
+				Description:         "Whether all the actions checked are allowed.",
+				MarkdownDescription: "Whether all the actions checked are allowed.",
+				Computed:            true,
+			},
+			"denied_actions": schema.ListAttribute{
+				Description:         "The actions of the checks which are denied.",
+				MarkdownDescription: "The actions of the checks which are denied.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+type PermissionCheckModel struct {
+	Organization  types.String `tfsdk:"organization"`
+	MemberID      types.Int64  `tfsdk:"member_id"`
+	Checks        types.List   `tfsdk:"checks"`
+	Results       types.List   `tfsdk:"results"`
+	AllAllowed    types.Bool   `tfsdk:"all_allowed"`
+	DeniedActions types.List   `tfsdk:"denied_actions"`
+}
+
+type CheckModel struct {
+	Action           types.String `tfsdk:"action"`
+	EntityCanonicals types.List   `tfsdk:"entity_canonicals"`
+}
+
+func ResultAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"action":            types.StringType,
+		"entity_canonicals": types.ListType{ElemType: types.StringType},
+		"allowed":           types.BoolType,
+	}
+}
@@ -0,0 +1,140 @@
+package datasource_policy_document
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func PolicyDocumentDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Composes the authorization rules of a cycloid_organization_role or a cycloid_organization_api_key: merges statements and existing rules, deduplicates them, checks their actions against the catalog of known Cycloid actions and validates their resources.",
+		MarkdownDescription: "Composes the authorization `rules` of a `cycloid_organization_role` or a `cycloid_organization_api_key`: merges statements and existing rules, deduplicates them, checks their actions against the catalog of known Cycloid actions and validates their resources.",
+		Attributes: map[string]schema.Attribute{
+			"statements": schema.ListNestedAttribute{
+				Description:         "The statements of the document, each one grants its actions on its resources.",
+				MarkdownDescription: "The statements of the document, each one grants its `actions` on its `resources`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"actions": schema.ListAttribute{
+							Description:         "The actions granted, like organization:project:read. Supports globs: * matches within a segment and ** any number of segments.",
+							MarkdownDescription: "The actions granted, like `organization:project:read`. Supports globs: `*` matches within a segment and `**` any number of segments.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"effect": schema.StringAttribute{
+							Description:         "The effect of the statement. Only allow is supported, the default.",
+							MarkdownDescription: "The effect of the statement. Only `allow` is supported, the default.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow"),
+							},
+						},
+						"resources": schema.ListAttribute{
+							Description:         "The resources the actions are granted on, like organization:my-org:project:my-project:environment:*. Omit or set to [] to grant them globally.",
+							MarkdownDescription: "The resources the actions are granted on, like `organization:my-org:project:my-project:environment:*`. Omit or set to `[]` to grant them globally.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"source_rules": schema.ListNestedAttribute{
+				Description:         "Existing rules to merge into the document, like the rules of another cycloid_policy_document.",
+				MarkdownDescription: "Existing rules to merge into the document, like the `rules` of another `cycloid_policy_document`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: RuleAttributes(false),
+				},
+			},
+			"expand_wildcards": schema.BoolAttribute{
+				Description:         "When true, the actions with globs are replaced by the known actions they match. Defaults to false, keeping the globs so they also match actions added later to Cycloid.",
+				MarkdownDescription: "When `true`, the actions with globs are replaced by the known actions they match. Defaults to `false`, keeping the globs so they also match actions added later to Cycloid.",
+				Optional:            true,
+			},
+			"allow_unknown_actions": schema.BoolAttribute{
+				Description:         "When true, the actions missing from the catalog of the provider are kept instead of failing, for actions newer than the provider.",
+				MarkdownDescription: "When `true`, the actions missing from the catalog of the provider are kept instead of failing, for actions newer than the provider.",
+				Optional:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description:         "The deduplicated rules, sorted by action, to set as the rules of a cycloid_organization_role or a cycloid_organization_api_key. The resources of the rules granting the same action are merged, a global one wins.",
+				MarkdownDescription: "The deduplicated rules, sorted by action, to set as the `rules` of a `cycloid_organization_role` or a `cycloid_organization_api_key`. The resources of the rules granting the same action are merged, a global one wins.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: RuleAttributes(true),
+				},
+			},
+			"actions": schema.ListAttribute{
+				Description:         "The actions of the rules.",
+				MarkdownDescription: "The actions of the `rules`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// RuleAttributes are the attributes of a rule, as the roles and API keys
+// take them.
+func RuleAttributes(computed bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"action": schema.StringAttribute{
+			Description:         "The action of the rule.",
+			MarkdownDescription: "The action of the rule.",
+			Required:            !computed,
+			Computed:            computed,
+		},
+		"effect": schema.StringAttribute{
+			Description:         "The effect of the rule, allow.",
+			MarkdownDescription: "The effect of the rule, `allow`.",
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"resources": schema.ListAttribute{
+			Description:         "The resources of the rule, empty when global.",
+			MarkdownDescription: "The resources of the rule, empty when global.",
+			Optional:            !computed,
+			Computed:            computed,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+type PolicyDocumentModel struct {
+	Statements          types.List `tfsdk:"statements"`
+	SourceRules         types.List `tfsdk:"source_rules"`
+	ExpandWildcards     types.Bool `tfsdk:"expand_wildcards"`
+	AllowUnknownActions types.Bool `tfsdk:"allow_unknown_actions"`
+	Rules               types.List `tfsdk:"rules"`
+	Actions             types.List `tfsdk:"actions"`
+}
+
+type StatementModel struct {
+	Actions   types.List   `tfsdk:"actions"`
+	Effect    types.String `tfsdk:"effect"`
+	Resources types.List   `tfsdk:"resources"`
+}
+
+type RuleModel struct {
+	Action    types.String `tfsdk:"action"`
+	Effect    types.String `tfsdk:"effect"`
+	Resources types.List   `tfsdk:"resources"`
+}
+
+func RuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"action":    types.StringType,
+		"effect":    types.StringType,
+		"resources": types.ListType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,73 @@
+package datasource_remote_tf_state_backend
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_external_backends"
+)
+
+func RemoteTfStateBackendDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Resolves the external backend Cycloid uses to store the Terraform state of a project, environment or component, and renders it as a Terraform backend configuration. The most specific `remote_tfstate` external backend is used: the one of the component, then of the environment, then of the project and finally the default one of the organization.",
+		MarkdownDescription: "Resolves the external backend Cycloid uses to store the Terraform state of a project, environment or component, and renders it as a Terraform [backend](https://developer.hashicorp.com/terraform/language/backend) configuration. The most specific `remote_tfstate` external backend is used: the one of the component, then of the environment, then of the project and finally the default one of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"project_canonical": schema.StringAttribute{
+				Description:         "The canonical of the project.",
+				MarkdownDescription: "The canonical of the project.",
+				Optional:            true,
+			},
+			"environment_canonical": schema.StringAttribute{
+				Description:         "The canonical of the environment.",
+				MarkdownDescription: "The canonical of the environment.",
+				Optional:            true,
+			},
+			"component_canonical": schema.StringAttribute{
+				Description:         "The canonical of the component.",
+				MarkdownDescription: "The canonical of the component.",
+				Optional:            true,
+			},
+			"scope": schema.StringAttribute{
+				Description:         "What the resolved external backend is scoped to: `component`, `environment`, `project` or `organization` for the default one.",
+				MarkdownDescription: "What the resolved external backend is scoped to: `component`, `environment`, `project` or `organization` for the default one.",
+				Computed:            true,
+			},
+			"external_backend": schema.SingleNestedAttribute{
+				Description:         "The resolved external backend.",
+				MarkdownDescription: "The resolved external backend.",
+				Computed:            true,
+				Attributes:          datasource_external_backends.ExternalBackendAttributes(),
+			},
+			"backend_type": schema.StringAttribute{
+				Description:         "The Terraform backend type matching the engine of the external backend: `s3`, `gcs`, `azurerm`, `swift` or `http`.",
+				MarkdownDescription: "The Terraform backend type matching the engine of the external backend: `s3`, `gcs`, `azurerm`, `swift` or `http`.",
+				Computed:            true,
+			},
+			"backend_config": schema.MapAttribute{
+				Description:         "The Terraform backend configuration matching the external backend. Credentials are not included. The default external backend of an organization is shared by every component, the state key is then only set when the external backend has one.",
+				MarkdownDescription: "The Terraform backend configuration matching the external backend. Credentials are not included. The default external backend of an organization is shared by every component, the state key is then only set when the external backend has one.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+type RemoteTfStateBackendModel struct {
+	Organization         types.String `tfsdk:"organization"`
+	ProjectCanonical     types.String `tfsdk:"project_canonical"`
+	EnvironmentCanonical types.String `tfsdk:"environment_canonical"`
+	ComponentCanonical   types.String `tfsdk:"component_canonical"`
+	Scope                types.String `tfsdk:"scope"`
+	ExternalBackend      types.Object `tfsdk:"external_backend"`
+	BackendType          types.String `tfsdk:"backend_type"`
+	BackendConfig        types.Map    `tfsdk:"backend_config"`
+}
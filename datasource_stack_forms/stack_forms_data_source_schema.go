@@ -0,0 +1,298 @@
+package datasource_stack_forms
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func StackFormsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Read the StackForms definition of a stack for a given version. Returns every use case with its sections, groups and variables, including defaults, types and validation rules, so modules can build `input_variables` for a `cycloid_component`.",
+		MarkdownDescription: "Read the [StackForms](https://docs.cycloid.io/reference/stackforms/) definition of a stack for a given version. Returns every use case with its sections, groups and variables, including defaults, types and validation rules, so modules can build `input_variables` for a `cycloid_component`.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical where the stack is available. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical where the stack is available. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"stack_ref": schema.StringAttribute{
+				Description:         "The stack reference, the format is <org>:<stack_canonical>.",
+				MarkdownDescription: "The stack reference, the format is `<org>:<stack_canonical>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[^:]+:[^:]+$`),
+						"must be in the format <organization>:<stack_canonical>",
+					),
+				},
+			},
+			"stack_version": schema.StringAttribute{
+				Description:         "The stack version to read, you can specify a branch name, a tag or a commit. Default to the catalog repository's default branch.",
+				MarkdownDescription: "The stack version to read, you can specify a branch name, a tag or a commit. Default to the catalog repository's default branch.",
+				Optional:            true,
+			},
+			"use_case": schema.StringAttribute{
+				Description:         "Only return this use case. All the use cases of the stack are returned when omitted.",
+				MarkdownDescription: "Only return this use case. All the use cases of the stack are returned when omitted.",
+				Optional:            true,
+			},
+			"commit_hash": schema.StringAttribute{
+				Description:         "The commit hash the stack version resolved to.",
+				MarkdownDescription: "The commit hash the stack version resolved to.",
+				Computed:            true,
+			},
+			"technologies": schema.ListNestedAttribute{
+				Description:         "Technologies used by the stack.",
+				MarkdownDescription: "Technologies used by the stack.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"technology": schema.StringAttribute{Computed: true},
+						"version":    schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"use_cases": schema.ListNestedAttribute{
+				Description:         "The use cases of the stack, sorted by use case.",
+				MarkdownDescription: "The use cases of the stack, sorted by use case.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"use_case": schema.StringAttribute{
+							Description:         "The use case key, to use as `use_case` on `cycloid_component`.",
+							MarkdownDescription: "The use case key, to use as `use_case` on `cycloid_component`.",
+							Computed:            true,
+						},
+						"name":           schema.StringAttribute{Computed: true},
+						"description":    schema.StringAttribute{Computed: true},
+						"cloud_provider": schema.StringAttribute{Computed: true},
+						"sections": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: sectionAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func sectionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name":        schema.StringAttribute{Computed: true},
+		"description": schema.StringAttribute{Computed: true},
+		"groups": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":        schema.StringAttribute{Computed: true},
+					"description": schema.StringAttribute{Computed: true},
+					"condition":   schema.StringAttribute{Computed: true},
+					"technologies": schema.ListAttribute{
+						Description:         "Technologies the variables of this group are sent to (terraform, ansible, pipeline...).",
+						MarkdownDescription: "Technologies the variables of this group are sent to (`terraform`, `ansible`, `pipeline`...).",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"folded":              schema.BoolAttribute{Computed: true},
+					"read_only":           schema.BoolAttribute{Computed: true},
+					"read_only_on_update": schema.BoolAttribute{Computed: true},
+					"vars": schema.ListNestedAttribute{
+						Description:         "The widgets of the group.",
+						MarkdownDescription: "The widgets of the group.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: varAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func varAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Description:         "The variable key, to use in `input_variables` of `cycloid_component`.",
+			MarkdownDescription: "The variable key, to use in `input_variables` of `cycloid_component`.",
+			Computed:            true,
+		},
+		"name":        schema.StringAttribute{Computed: true},
+		"description": schema.StringAttribute{Computed: true},
+		"widget": schema.StringAttribute{
+			Description:         "The widget used to display the variable, e.g. simple_text, dropdown, switch.",
+			MarkdownDescription: "The widget used to display the variable, e.g. `simple_text`, `dropdown`, `switch`.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "The type of the variable, one of integer, float, string, array, boolean or map.",
+			MarkdownDescription: "The type of the variable, one of `integer`, `float`, `string`, `array`, `boolean` or `map`.",
+			Computed:            true,
+		},
+		"unit":                schema.StringAttribute{Computed: true},
+		"required":            schema.BoolAttribute{Computed: true},
+		"condition":           schema.StringAttribute{Computed: true},
+		"depends_on":          schema.ListAttribute{ElementType: types.StringType, Computed: true},
+		"folded":              schema.BoolAttribute{Computed: true},
+		"read_only":           schema.BoolAttribute{Computed: true},
+		"read_only_on_update": schema.BoolAttribute{Computed: true},
+		"values_ref":          schema.StringAttribute{Computed: true},
+		"default": schema.StringAttribute{
+			Description:         "The default value as JSON, decode it with jsondecode(). Can be a conditional default object.",
+			MarkdownDescription: "The default value as JSON, decode it with `jsondecode()`. Can be a conditional default object.",
+			Computed:            true,
+		},
+		"values": schema.StringAttribute{
+			Description:         "The allowed values as JSON, decode it with jsondecode().",
+			MarkdownDescription: "The allowed values as JSON, decode it with `jsondecode()`.",
+			Computed:            true,
+		},
+		"validations": schema.StringAttribute{
+			Description:         "The validation rules as a JSON list, decode it with jsondecode().",
+			MarkdownDescription: "The validation rules as a JSON list, decode it with `jsondecode()`.",
+			Computed:            true,
+		},
+		"widget_config": schema.StringAttribute{
+			Description:         "The widget configuration as JSON, decode it with jsondecode().",
+			MarkdownDescription: "The widget configuration as JSON, decode it with `jsondecode()`.",
+			Computed:            true,
+		},
+		"items": schema.StringAttribute{
+			Description:         "The sub-variables of a repeatable widget as JSON, decode it with jsondecode().",
+			MarkdownDescription: "The sub-variables of a `repeatable` widget as JSON, decode it with `jsondecode()`.",
+			Computed:            true,
+		},
+	}
+}
+
+func TechnologyAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"technology": types.StringType,
+		"version":    types.StringType,
+	}
+}
+
+func UseCaseAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"use_case":       types.StringType,
+		"name":           types.StringType,
+		"description":    types.StringType,
+		"cloud_provider": types.StringType,
+		"sections":       types.ListType{ElemType: types.ObjectType{AttrTypes: SectionAttrTypes()}},
+	}
+}
+
+func SectionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"groups":      types.ListType{ElemType: types.ObjectType{AttrTypes: GroupAttrTypes()}},
+	}
+}
+
+func GroupAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":                types.StringType,
+		"description":         types.StringType,
+		"condition":           types.StringType,
+		"technologies":        types.ListType{ElemType: types.StringType},
+		"folded":              types.BoolType,
+		"read_only":           types.BoolType,
+		"read_only_on_update": types.BoolType,
+		"vars":                types.ListType{ElemType: types.ObjectType{AttrTypes: VarAttrTypes()}},
+	}
+}
+
+func VarAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":                 types.StringType,
+		"name":                types.StringType,
+		"description":         types.StringType,
+		"widget":              types.StringType,
+		"type":                types.StringType,
+		"unit":                types.StringType,
+		"required":            types.BoolType,
+		"condition":           types.StringType,
+		"depends_on":          types.ListType{ElemType: types.StringType},
+		"folded":              types.BoolType,
+		"read_only":           types.BoolType,
+		"read_only_on_update": types.BoolType,
+		"values_ref":          types.StringType,
+		"default":             types.StringType,
+		"values":              types.StringType,
+		"validations":         types.StringType,
+		"widget_config":       types.StringType,
+		"items":               types.StringType,
+	}
+}
+
+type StackFormsModel struct {
+	Organization types.String `tfsdk:"organization"`
+	StackRef     types.String `tfsdk:"stack_ref"`
+	StackVersion types.String `tfsdk:"stack_version"`
+	UseCase      types.String `tfsdk:"use_case"`
+	CommitHash   types.String `tfsdk:"commit_hash"`
+	Technologies types.List   `tfsdk:"technologies"`
+	UseCases     types.List   `tfsdk:"use_cases"`
+}
+
+type TechnologyItem struct {
+	Technology types.String `tfsdk:"technology"`
+	Version    types.String `tfsdk:"version"`
+}
+
+type UseCaseItem struct {
+	UseCase       types.String  `tfsdk:"use_case"`
+	Name          types.String  `tfsdk:"name"`
+	Description   types.String  `tfsdk:"description"`
+	CloudProvider types.String  `tfsdk:"cloud_provider"`
+	Sections      []SectionItem `tfsdk:"sections"`
+}
+
+type SectionItem struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Groups      []GroupItem  `tfsdk:"groups"`
+}
+
+type GroupItem struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Condition        types.String `tfsdk:"condition"`
+	Technologies     []string     `tfsdk:"technologies"`
+	Folded           types.Bool   `tfsdk:"folded"`
+	ReadOnly         types.Bool   `tfsdk:"read_only"`
+	ReadOnlyOnUpdate types.Bool   `tfsdk:"read_only_on_update"`
+	Vars             []VarItem    `tfsdk:"vars"`
+}
+
+type VarItem struct {
+	Key              types.String `tfsdk:"key"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Widget           types.String `tfsdk:"widget"`
+	Type             types.String `tfsdk:"type"`
+	Unit             types.String `tfsdk:"unit"`
+	Required         types.Bool   `tfsdk:"required"`
+	Condition        types.String `tfsdk:"condition"`
+	DependsOn        []string     `tfsdk:"depends_on"`
+	Folded           types.Bool   `tfsdk:"folded"`
+	ReadOnly         types.Bool   `tfsdk:"read_only"`
+	ReadOnlyOnUpdate types.Bool   `tfsdk:"read_only_on_update"`
+	ValuesRef        types.String `tfsdk:"values_ref"`
+	Default          types.String `tfsdk:"default"`
+	Values           types.String `tfsdk:"values"`
+	Validations      types.String `tfsdk:"validations"`
+	WidgetConfig     types.String `tfsdk:"widget_config"`
+	Items            types.String `tfsdk:"items"`
+}
@@ -1,4 +1,8 @@
 // Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+//
+// Manual additions: the filters, keywords, technologies, cloud_providers,
+// visibility and team arguments and their StacksModel fields were added by
+// hand, the filters block is the one of internal/lhsfilter.
 
 package datasource_stacks
 
@@ -17,11 +21,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
 )
 
 func StacksDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"cloud_providers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Only return stacks supporting at least one of these cloud provider canonicals, for example aws or gcp. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+				MarkdownDescription: "Only return stacks supporting at least one of these cloud provider canonicals, for example `aws` or `gcp`. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+			},
+			"filters": lhsfilter.Attribute(
+				"stacks", "service_catalog_blueprint",
+				"The stacks list endpoint supports the service_catalog_ref, service_catalog_visibility, service_catalog_author, service_catalog_blueprint, service_catalog_form_enabled, service_catalog_source_canonical and user_canonical attributes. The keywords, technologies, cloud_providers and team arguments have no LHS filter attribute and are applied by the provider on the listed stacks.",
+			),
+			"keywords": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Only return stacks having at least one of these keywords. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+				MarkdownDescription: "Only return stacks having at least one of these keywords. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+			},
 			"organization_canonical": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -32,6 +54,25 @@ func StacksDataSourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z0-9]+[a-z0-9\\-_]+[a-z0-9]+$"), ""),
 				},
 			},
+			"team": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return stacks maintained by the team with this canonical. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+				MarkdownDescription: "Only return stacks maintained by the team with this canonical. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+			},
+			"technologies": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Only return stacks using at least one of these technologies, for example terraform or ansible. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+				MarkdownDescription: "Only return stacks using at least one of these technologies, for example `terraform` or `ansible`. Applied by the provider on the listed stacks, the API has no LHS filter for it.",
+			},
+			"visibility": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return stacks with this visibility. Sent to the API as a service_catalog_visibility LHS filter.",
+				MarkdownDescription: "Only return stacks with this visibility, one of `local`, `shared` or `hidden`. Sent to the API as a `service_catalog_visibility` LHS filter.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("local", "shared", "hidden"),
+				},
+			},
 			"stacks": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -179,8 +220,14 @@ func StacksDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type StacksModel struct {
+	CloudProviders        types.List   `tfsdk:"cloud_providers"`
+	Filters               types.List   `tfsdk:"filters"`
+	Keywords              types.List   `tfsdk:"keywords"`
 	OrganizationCanonical types.String `tfsdk:"organization_canonical"`
 	Stacks                types.List   `tfsdk:"stacks"`
+	Team                  types.String `tfsdk:"team"`
+	Technologies          types.List   `tfsdk:"technologies"`
+	Visibility            types.String `tfsdk:"visibility"`
 }
 
 var _ basetypes.ObjectTypable = StacksType{}
@@ -0,0 +1,37 @@
+package datasource_team
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_teams"
+)
+
+func TeamDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := datasource_teams.TeamAttributes()
+	attributes["organization"] = schema.StringAttribute{
+		Description:         "The organization canonical of the team. Defaults to the provider's `default_organization`.",
+		MarkdownDescription: "The organization canonical of the team. Defaults to the provider's `default_organization`.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["canonical"] = schema.StringAttribute{
+		Description:         "The canonical of the team.",
+		MarkdownDescription: "The canonical of the team.",
+		Required:            true,
+	}
+
+	return schema.Schema{
+		Description:         "Reads a team of an organization by its canonical.",
+		MarkdownDescription: "Reads a team of an organization by its canonical.",
+		Attributes:          attributes,
+	}
+}
+
+type TeamModel struct {
+	datasource_teams.TeamModel
+
+	Organization types.String `tfsdk:"organization"`
+}
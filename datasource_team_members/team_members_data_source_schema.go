@@ -0,0 +1,91 @@
+package datasource_team_members
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+func TeamMembersDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the members of a team, optionally filtered with LHS filters. Member emails are stored in Terraform state — protect your state backend accordingly.",
+		MarkdownDescription: "Lists the members of a team, optionally filtered with LHS filters. Member emails are stored in Terraform state — protect your state backend accordingly.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the team. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical of the team. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"team": schema.StringAttribute{
+				Description:         "The canonical of the team.",
+				MarkdownDescription: "The canonical of the team.",
+				Required:            true,
+			},
+			"filters": lhsfilter.Attribute("the team members", "canonical", ""),
+			"members": schema.ListNestedAttribute{
+				Description:         "The members of the team matching the filters.",
+				MarkdownDescription: "The members of the team matching the `filters`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.Int64Attribute{
+							Description:         "The ID of the member.",
+							MarkdownDescription: "The ID of the member.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							Description:         "The username of the member.",
+							MarkdownDescription: "The username of the member.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							Description:         "The email of the member.",
+							MarkdownDescription: "The email of the member.",
+							Computed:            true,
+						},
+						"full_name": schema.StringAttribute{
+							Description:         "The full name of the member.",
+							MarkdownDescription: "The full name of the member.",
+							Computed:            true,
+						},
+						"mfa_enabled": schema.BoolAttribute{
+							Description:         "Whether the member has enabled MFA.",
+							MarkdownDescription: "Whether the member has enabled MFA.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TeamMembersModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Team         types.String `tfsdk:"team"`
+	Filters      types.List   `tfsdk:"filters"`
+	Members      types.List   `tfsdk:"members"`
+}
+
+type MemberModel struct {
+	MemberID   types.Int64  `tfsdk:"member_id"`
+	Username   types.String `tfsdk:"username"`
+	Email      types.String `tfsdk:"email"`
+	FullName   types.String `tfsdk:"full_name"`
+	MfaEnabled types.Bool   `tfsdk:"mfa_enabled"`
+}
+
+func MemberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"member_id":   types.Int64Type,
+		"username":    types.StringType,
+		"email":       types.StringType,
+		"full_name":   types.StringType,
+		"mfa_enabled": types.BoolType,
+	}
+}
@@ -0,0 +1,111 @@
+package datasource_teams
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+// TeamAttributes are the attributes of a team.
+func TeamAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"canonical": schema.StringAttribute{
+			Description:         "The canonical of the team.",
+			MarkdownDescription: "The canonical of the team.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the team.",
+			MarkdownDescription: "The name of the team.",
+			Computed:            true,
+		},
+		"team_id": schema.Int64Attribute{
+			Description:         "The ID of the team.",
+			MarkdownDescription: "The ID of the team.",
+			Computed:            true,
+		},
+		"owner": schema.StringAttribute{
+			Description:         "The username of the owner of the team.",
+			MarkdownDescription: "The username of the owner of the team.",
+			Computed:            true,
+		},
+		"roles": schema.ListAttribute{
+			Description:         "The canonicals of the roles of the team.",
+			MarkdownDescription: "The canonicals of the roles of the team.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"member_count": schema.Int64Attribute{
+			Description:         "The number of members of the team.",
+			MarkdownDescription: "The number of members of the team.",
+			Computed:            true,
+		},
+	}
+}
+
+func TeamsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Lists the teams of an organization, optionally filtered on their name, a member or LHS filters.",
+		MarkdownDescription: "Lists the teams of an organization, optionally filtered on their name, a member or LHS filters.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the teams. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical of the teams. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				Description:         "Only return the teams with this name.",
+				MarkdownDescription: "Only return the teams with this name.",
+				Optional:            true,
+			},
+			"member_id": schema.Int64Attribute{
+				Description:         "Only return the teams the member with this ID belongs to.",
+				MarkdownDescription: "Only return the teams the member with this ID belongs to.",
+				Optional:            true,
+			},
+			"filters": lhsfilter.Attribute("teams", "canonical", ""),
+			"teams": schema.ListNestedAttribute{
+				Description:         "The teams matching all the filters set.",
+				MarkdownDescription: "The teams matching all the filters set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: TeamAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// TeamModel holds the attributes of TeamAttributes.
+type TeamModel struct {
+	Canonical   types.String `tfsdk:"canonical"`
+	Name        types.String `tfsdk:"name"`
+	TeamID      types.Int64  `tfsdk:"team_id"`
+	Owner       types.String `tfsdk:"owner"`
+	Roles       types.List   `tfsdk:"roles"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+}
+
+type TeamsModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Name         types.String `tfsdk:"name"`
+	MemberID     types.Int64  `tfsdk:"member_id"`
+	Filters      types.List   `tfsdk:"filters"`
+	Teams        types.List   `tfsdk:"teams"`
+}
+
+func TeamAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"canonical":    types.StringType,
+		"name":         types.StringType,
+		"team_id":      types.Int64Type,
+		"owner":        types.StringType,
+		"roles":        types.ListType{ElemType: types.StringType},
+		"member_count": types.Int64Type,
+	}
+}
@@ -0,0 +1,147 @@
+package ephemeral_credential
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var canonicalRegex = regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`)
+
+func CredentialEphemeralResourceSchema(ctx context.Context) schema.Schema {
+	desc := "Fetches a credential and its secrets without storing them in the Terraform state or plan, for example to configure another provider from a credential stored in Cycloid. Only the `body` fields used by the credential `type` are set, the others are null. Requires Terraform 1.10 or later."
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "Organization canonical of the credential. Defaults to provider `default_organization`.",
+				MarkdownDescription: "Organization canonical of the credential. Defaults to provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"canonical": schema.StringAttribute{
+				Description:         "The canonical of the credential.",
+				MarkdownDescription: "The canonical of the credential.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The name of the credential.",
+				MarkdownDescription: "The name of the credential.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				Description:         "The type of the credential.",
+				MarkdownDescription: "The type of the credential, see [the docs](https://docs.cycloid.io/reference/credentials/concepts).",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				Description:         "The credential path written in vault and used in pipelines.",
+				MarkdownDescription: "The credential path written in vault and used in `pipelines`.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				Description:         "The description of the credential.",
+				MarkdownDescription: "The description of the credential.",
+				Computed:            true,
+			},
+			"owner": schema.StringAttribute{
+				Description:         "The username of the owner of the credential.",
+				MarkdownDescription: "The username of the owner of the credential.",
+				Computed:            true,
+			},
+			"keys": schema.ListAttribute{
+				Description:         "The keys of the credential values.",
+				MarkdownDescription: "The keys of the credential values.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"body": schema.SingleNestedAttribute{
+				Description:         "The credential values, only the fields used by the credential type are set.",
+				MarkdownDescription: "The credential values, only the fields used by the credential `type` are set.",
+				Computed:            true,
+				Sensitive:           true,
+				Attributes: map[string]schema.Attribute{
+					"access_key":      bodyStringAttribute("The access key, for type `aws` or `azure_storage`."),
+					"account_name":    bodyStringAttribute("The account name, for type `azure_storage`."),
+					"auth_url":        bodyStringAttribute("The authentication URL, for type `swift`."),
+					"ca_cert":         bodyStringAttribute("The CA certificate, for type `elasticsearch`."),
+					"client_id":       bodyStringAttribute("The client ID, for type `azure`."),
+					"client_secret":   bodyStringAttribute("The client secret, for type `azure`."),
+					"domain_id":       bodyStringAttribute("The domain ID, for type `swift`."),
+					"environment":     bodyStringAttribute("The Azure environment, for type `azure` or `azure_storage`."),
+					"json_key":        bodyStringAttribute("The service account JSON key, for type `gcp`."),
+					"password":        bodyStringAttribute("The password, for type `basic_auth`, `elasticsearch`, `swift` or `vmware`."),
+					"secret_key":      bodyStringAttribute("The secret key, for type `aws`."),
+					"ssh_key":         bodyStringAttribute("The SSH private key, for type `ssh`."),
+					"subscription_id": bodyStringAttribute("The subscription ID, for type `azure`."),
+					"tenant_id":       bodyStringAttribute("The tenant ID, for type `azure` or `swift`."),
+					"username":        bodyStringAttribute("The username, for type `basic_auth`, `elasticsearch`, `swift` or `vmware`."),
+					"raw": schema.MapAttribute{
+						Description:         "The credential fields, for type custom.",
+						MarkdownDescription: "The credential fields, for type `custom`.",
+						Computed:            true,
+						Sensitive:           true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+func bodyStringAttribute(desc string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Computed:            true,
+		Sensitive:           true,
+	}
+}
+
+type CredentialModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Canonical    types.String `tfsdk:"canonical"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Path         types.String `tfsdk:"path"`
+	Description  types.String `tfsdk:"description"`
+	Owner        types.String `tfsdk:"owner"`
+	Keys         types.List   `tfsdk:"keys"`
+	Body         types.Object `tfsdk:"body"`
+}
+
+func BodyAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"access_key":      types.StringType,
+		"account_name":    types.StringType,
+		"auth_url":        types.StringType,
+		"ca_cert":         types.StringType,
+		"client_id":       types.StringType,
+		"client_secret":   types.StringType,
+		"domain_id":       types.StringType,
+		"environment":     types.StringType,
+		"json_key":        types.StringType,
+		"password":        types.StringType,
+		"secret_key":      types.StringType,
+		"ssh_key":         types.StringType,
+		"subscription_id": types.StringType,
+		"tenant_id":       types.StringType,
+		"username":        types.StringType,
+		"raw":             types.MapType{ElemType: types.StringType},
+	}
+}
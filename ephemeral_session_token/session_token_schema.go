@@ -0,0 +1,81 @@
+package ephemeral_session_token
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var canonicalRegex = regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`)
+
+func SessionTokenEphemeralResourceSchema(ctx context.Context) schema.Schema {
+	desc := "Produces a short-lived Cycloid session token (JWT) without storing it in the Terraform state or plan, for example to configure an aliased `cycloid` provider scoped to a child organization. The token is obtained by logging in with `email` and `password`, or else by refreshing the token of the provider. Requires Terraform 1.10 or later."
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "Organization canonical the token is for. Defaults to provider `default_organization`.",
+				MarkdownDescription: "Organization canonical the token is for. Defaults to provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"child_organization": schema.StringAttribute{
+				Description:         "The canonical of a child organization of `organization` to scope the token to.",
+				MarkdownDescription: "The canonical of a child organization of `organization` to scope the token to.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description:         "The email of the user to log in as. When omitted, the token of the provider is refreshed instead.",
+				MarkdownDescription: "The email of the user to log in as. When omitted, the token of the provider is refreshed instead.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "The password of the user to log in as.",
+				MarkdownDescription: "The password of the user to log in as.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("email")),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description:         "The session token.",
+				MarkdownDescription: "The session token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description:         "When the token expires, in RFC 3339 format.",
+				MarkdownDescription: "When the `token` expires, in RFC 3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type SessionTokenModel struct {
+	Organization      types.String `tfsdk:"organization"`
+	ChildOrganization types.String `tfsdk:"child_organization"`
+	Email             types.String `tfsdk:"email"`
+	Password          types.String `tfsdk:"password"`
+	Token             types.String `tfsdk:"token"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+}
@@ -0,0 +1,26 @@
+variable "monthly_budget" {
+  type    = number
+  default = 25000
+}
+
+data "cycloid_cloud_cost_dashboard" "last_month" {
+  begin     = "2026-09-01"
+  end       = "2026-09-30"
+  providers = ["aws", "gcp"]
+  tags = {
+    "cost-center" = "platform"
+  }
+}
+
+check "platform_budget" {
+  assert {
+    condition     = data.cycloid_cloud_cost_dashboard.last_month.total_cost <= var.monthly_budget
+    error_message = "The platform spent ${data.cycloid_cloud_cost_dashboard.last_month.total_cost} last month, over its budget of ${var.monthly_budget}."
+  }
+}
+
+output "cost_per_project" {
+  value = {
+    for p in data.cycloid_cloud_cost_dashboard.last_month.project_costs : p.project => p.cost
+  }
+}
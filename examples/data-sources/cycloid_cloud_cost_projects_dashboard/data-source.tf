@@ -0,0 +1,14 @@
+data "cycloid_cloud_cost_projects_dashboard" "this_year" {
+  begin       = "2026-01-01"
+  end         = "2026-12-31"
+  granularity = "month"
+  projects    = ["webshop", "data-platform"]
+}
+
+# Monthly cost of each project
+output "monthly_project_costs" {
+  value = {
+    for month in data.cycloid_cloud_cost_projects_dashboard.this_year.projects_histogram.buckets :
+    month.value => { for p in month.buckets : p.value => p.cost }
+  }
+}
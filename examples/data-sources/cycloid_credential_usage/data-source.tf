@@ -0,0 +1,11 @@
+data "cycloid_credential_usage" "aws" {
+  canonical = "aws-production"
+}
+
+output "aws_credential_in_use" {
+  value = data.cycloid_credential_usage.aws.in_use
+}
+
+output "aws_credential_cloud_accounts" {
+  value = [for ca in data.cycloid_credential_usage.aws.cloud_accounts : ca.canonical]
+}
@@ -0,0 +1,9 @@
+# Look up the default logs backend of the organization
+data "cycloid_external_backend" "logs" {
+  purpose = "logs"
+  default = true
+}
+
+output "logs_engine" {
+  value = data.cycloid_external_backend.logs.engine
+}
@@ -0,0 +1,8 @@
+data "cycloid_external_backends" "tfstate" {
+  purpose = "remote_tfstate"
+  engine  = "aws_storage"
+}
+
+output "tfstate_buckets" {
+  value = [for eb in data.cycloid_external_backends.tfstate.external_backends : eb.configuration["bucket"]]
+}
@@ -0,0 +1,15 @@
+data "cycloid_oidc_group_mappings" "all" {}
+
+# The mappings pointing to a deleted team
+output "orphan_mappings" {
+  value = [for m in data.cycloid_oidc_group_mappings.all.mappings : m.group_name if m.team_canonical == null]
+}
+
+# The roles granted by each OIDC group
+output "roles_by_group" {
+  value = {
+    for group, _ in data.cycloid_oidc_group_mappings.all.groups : group => distinct(flatten([
+      for m in data.cycloid_oidc_group_mappings.all.mappings : m.team_roles if m.group_name == group
+    ]))
+  }
+}
@@ -0,0 +1,12 @@
+data "cycloid_organization_invitations" "expired" {
+  # The validity period of the invitations of the Cycloid instance
+  invitation_validity_days = 7
+  status                   = "expired"
+}
+
+# Who has not accepted their invitation in time
+output "expired_invitations" {
+  value = {
+    for i in data.cycloid_organization_invitations.expired.invitations : i.email => i.invited_by
+  }
+}
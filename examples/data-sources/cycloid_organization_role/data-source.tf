@@ -0,0 +1,17 @@
+data "cycloid_organization_role" "devops" {
+  canonical = "organization-devops"
+}
+
+# A role extending the devops role with the rights on the API keys
+data "cycloid_policy_document" "devops_api_keys" {
+  source_rules = data.cycloid_organization_role.devops.rules
+  statements = [
+    { actions = ["organization:api_key:*"] },
+  ]
+}
+
+resource "cycloid_organization_role" "devops_api_keys" {
+  name        = "DevOps with API keys"
+  description = "The devops role, with the rights on the API keys."
+  rules       = data.cycloid_policy_document.devops_api_keys.rules
+}
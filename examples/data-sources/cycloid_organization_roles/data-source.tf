@@ -0,0 +1,10 @@
+# The roles which are not default roles of Cycloid
+data "cycloid_organization_roles" "custom" {
+  filters = [
+    { attribute = "default", condition = "eq", value = "false" },
+  ]
+}
+
+output "custom_roles" {
+  value = data.cycloid_organization_roles.custom.roles[*].canonical
+}
@@ -0,0 +1,40 @@
+# Check the API key of the provider can deploy the website before changing it
+data "cycloid_permission_check" "deployer" {
+  checks = [
+    {
+      action            = "organization:environment:update"
+      entity_canonicals = ["website", "prod"]
+    },
+    {
+      action            = "organization:pipeline:update"
+      entity_canonicals = ["website", "prod", "frontend"]
+    },
+  ]
+}
+
+resource "cycloid_environment" "prod" {
+  project   = "website"
+  canonical = "prod"
+
+  lifecycle {
+    precondition {
+      condition     = data.cycloid_permission_check.deployer.all_allowed
+      error_message = "The API key is missing: ${join(", ", data.cycloid_permission_check.deployer.denied_actions)}."
+    }
+  }
+}
+
+# Check a member cannot delete projects
+data "cycloid_permission_check" "viewer" {
+  member_id = 42
+  checks = [
+    { action = "organization:project:delete", entity_canonicals = ["website"] },
+  ]
+}
+
+check "viewer_is_read_only" {
+  assert {
+    condition     = !data.cycloid_permission_check.viewer.results[0].allowed
+    error_message = "The viewer role must not allow deleting projects."
+  }
+}
@@ -0,0 +1,37 @@
+data "cycloid_policy_document" "readers" {
+  statements = [
+    {
+      actions = ["organization:project:read", "organization:environment:read"]
+    },
+  ]
+}
+
+data "cycloid_policy_document" "website_operators" {
+  # Start from the readers rules
+  source_rules = data.cycloid_policy_document.readers.rules
+
+  statements = [
+    {
+      # Expanded below to every known pipeline action
+      actions   = ["organization:pipeline:*"]
+      resources = ["organization:my-org:project:website:environment:*"]
+    },
+    {
+      # Already granted globally by the readers rules, deduplicated
+      actions   = ["organization:project:read"]
+      resources = ["organization:my-org:project:website"]
+    },
+  ]
+
+  expand_wildcards = true
+}
+
+resource "cycloid_organization_role" "website_operators" {
+  name  = "Website operators"
+  rules = data.cycloid_policy_document.website_operators.rules
+}
+
+resource "cycloid_organization_api_key" "website_ci" {
+  name  = "Website CI"
+  rules = data.cycloid_policy_document.website_operators.rules
+}
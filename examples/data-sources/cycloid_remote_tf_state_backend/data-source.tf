@@ -0,0 +1,17 @@
+data "cycloid_remote_tf_state_backend" "prod" {
+  project_canonical     = "website"
+  environment_canonical = "prod"
+}
+
+# Render a backend configuration file matching what Cycloid uses, to pass
+# to `terraform init -backend-config=backend.hcl`
+resource "local_file" "backend_config" {
+  filename = "${path.module}/backend.hcl"
+  content = join("\n", [
+    for k, v in data.cycloid_remote_tf_state_backend.prod.backend_config : "${k} = ${jsonencode(v)}"
+  ])
+}
+
+output "backend_type" {
+  value = data.cycloid_remote_tf_state_backend.prod.backend_type
+}
@@ -0,0 +1,21 @@
+# Read the forms of the `default` use case of a released stack version.
+data "cycloid_stack_forms" "web_app" {
+  stack_ref     = "my-org:web-app-stack"
+  stack_version = "v2.1.0"
+  use_case      = "default"
+}
+
+locals {
+  # Default values of every variable, in the shape expected by
+  # `cycloid_component.input_variables`.
+  web_app_defaults = {
+    for section in data.cycloid_stack_forms.web_app.use_cases[0].sections :
+    lower(section.name) => {
+      for group in section.groups :
+      lower(group.name) => {
+        for v in group.vars :
+        v.key => jsondecode(v.default) if v.default != null
+      }
+    }
+  }
+}
@@ -0,0 +1,32 @@
+# Every stack of the organization
+data "cycloid_stacks" "all" {
+  organization_canonical = "my-org"
+}
+
+# Shared terraform stacks on AWS maintained by the platform team
+data "cycloid_stacks" "platform_aws" {
+  organization_canonical = "my-org"
+
+  technologies    = ["terraform"]
+  cloud_providers = ["aws"]
+  visibility      = "shared"
+  team            = "platform"
+}
+
+# Filters are applied by the API, before the arguments above
+data "cycloid_stacks" "blueprints" {
+  organization_canonical = "my-org"
+
+  filters = [
+    {
+      attribute = "service_catalog_blueprint"
+      condition = "eq"
+      value     = "true"
+    },
+  ]
+  keywords = ["kubernetes", "k8s"]
+}
+
+output "platform_aws_stack_refs" {
+  value = data.cycloid_stacks.platform_aws.stacks[*].ref
+}
@@ -0,0 +1,7 @@
+data "cycloid_team" "sre" {
+  canonical = "sre"
+}
+
+output "sre_roles" {
+  value = data.cycloid_team.sre.roles
+}
@@ -0,0 +1,8 @@
+data "cycloid_team_members" "sre" {
+  team = "sre"
+}
+
+# The members of the team without MFA
+output "sre_without_mfa" {
+  value = [for m in data.cycloid_team_members.sre.members : m.username if !m.mfa_enabled]
+}
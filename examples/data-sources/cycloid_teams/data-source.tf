@@ -0,0 +1,15 @@
+# The teams whose name starts with "platform"
+data "cycloid_teams" "platform" {
+  filters = [
+    { attribute = "name", condition = "rlike", value = "^platform" },
+  ]
+}
+
+# The teams a member belongs to
+data "cycloid_teams" "of_member" {
+  member_id = 42
+}
+
+output "platform_teams" {
+  value = data.cycloid_teams.platform.teams[*].canonical
+}
@@ -0,0 +1,11 @@
+# The AWS keys stored in Cycloid configure the AWS provider without being
+# written to the Terraform state or plan.
+ephemeral "cycloid_credential" "aws" {
+  canonical = "aws-production"
+}
+
+provider "aws" {
+  region     = "eu-west-1"
+  access_key = ephemeral.cycloid_credential.aws.body.access_key
+  secret_key = ephemeral.cycloid_credential.aws.body.secret_key
+}
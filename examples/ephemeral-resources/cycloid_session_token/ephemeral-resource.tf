@@ -0,0 +1,12 @@
+# A short-lived token scoped to a child organization configures an aliased
+# provider, nothing long-lived is shared with the child organization.
+ephemeral "cycloid_session_token" "customer" {
+  child_organization = "customer-a"
+}
+
+provider "cycloid" {
+  alias                = "customer"
+  api_url              = var.cycloid_api_url
+  api_key              = ephemeral.cycloid_session_token.customer.token
+  default_organization = "customer-a"
+}
@@ -13,6 +13,18 @@ resource "cycloid_catalog_repository" "tf_catalog_repository" {
   credential_canonical = cycloid_credential.tf_credential_catalog_repo.canonical
   url = var.catalog_repository_url
   branch = var.catalog_repository_branch
+
+  # Fail the plan early if the credential cannot read the repository
+  validate_connection = true
+
+  # Components using these stacks may only use tags and release branches
+  version_policy = {
+    allowed_branches = ["release/*"]
+  }
+}
+
+output "catalog_tags" {
+  value = [for v in cycloid_catalog_repository.tf_catalog_repository.versions : v.name if v.type == "tag"]
 }
 
 provider "cycloid" {
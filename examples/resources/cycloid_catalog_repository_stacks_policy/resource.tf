@@ -0,0 +1,27 @@
+resource "cycloid_catalog_repository_stacks_policy" "platform" {
+  organization                 = "my-org"
+  catalog_repository_canonical = cycloid_catalog_repository.platform.canonical
+
+  # Applied to every stack of the catalog repository, existing or added later.
+  visibility = "shared"
+  team       = "platform"
+
+  # Stacks left untouched by the policy.
+  exclude = ["legacy-vm"]
+
+  # Per-stack settings, unset attributes fall back to the policy values.
+  overrides = {
+    "internal-tools" = {
+      visibility = "local"
+    }
+    "sandbox" = {
+      visibility = "hidden"
+      team       = ""
+    }
+  }
+}
+
+# Stacks that drifted from the policy show up as changes in the plan.
+output "platform_stacks" {
+  value = cycloid_catalog_repository_stacks_policy.platform.stacks
+}
@@ -32,3 +32,22 @@ resource "cycloid_cloud_account" "vsphere_homelab" {
   cloud_provider       = "vsphere"
   credential_canonical = cycloid_credential.vsphere.canonical
 }
+
+# The credential is created along with the cloud account
+resource "cycloid_cloud_account" "gcp_data" {
+  name           = "GCP data"
+  cloud_provider = "gcp"
+  credential = {
+    type = "gcp"
+    body = {
+      json_key = var.gcp_json_key
+    }
+  }
+
+  configuration = {
+    gcp = {
+      project = "acme-data"
+      region  = "europe-west1"
+    }
+  }
+}
@@ -0,0 +1,30 @@
+resource "cycloid_cloud_account" "aws_payer" {
+  name                 = "AWS payer"
+  cloud_provider       = "aws"
+  credential_canonical = cycloid_credential.aws_payer.canonical
+}
+
+resource "cycloid_cloud_cost_management_account" "aws_payer" {
+  cloud_account_canonical = cycloid_cloud_account.aws_payer.canonical
+
+  aws_cur = {
+    bucket = "acme-cur-reports"
+    region = "us-east-1"
+    prefix = "cur/cycloid"
+  }
+
+  linked_account_names = {
+    "123456789012" = "Production"
+    "210987654321" = "Staging"
+  }
+}
+
+resource "cycloid_cloud_cost_management_account" "gcp_billing" {
+  cloud_account_canonical = cycloid_cloud_account.gcp_data.canonical
+
+  gcp_billing_export = {
+    project_id = "acme-billing"
+    dataset    = "billing_export"
+    table      = "gcp_billing_export_v1_012345_ABCDEF_678901"
+  }
+}
@@ -0,0 +1,8 @@
+resource "cycloid_cloud_cost_tag_mapping" "this" {
+  project_tags     = ["cycloid:project", "project"]
+  environment_tags = ["cycloid:env", "env"]
+  component_tags   = ["cycloid:component"]
+
+  # "team-backend-prod" is mapped to the "prod" environment
+  environment_regex = "-([a-z]+)$"
+}
@@ -108,4 +108,36 @@ resource "cycloid_component" "dev_environment" {
   allow_version_update  = true
   allow_variable_update = true
   allow_destroy         = false
-}
\ No newline at end of file
+}
+
+# Example 5: Version Policy
+# Only released versions of the stacks of a catalog repository may be used.
+# The policy is declared on the catalog repository and checked at plan time
+# on each component referencing it in catalog_version_policy. version_policy
+# narrows it for one component: stack_version must be accepted by both.
+resource "cycloid_catalog_repository" "releases" {
+  organization_canonical = "my-org"
+  name                   = "releases"
+  url                    = "git@github.com:my-org/stacks.git"
+  branch                 = "main"
+  credential_canonical   = "github-deploy-key"
+
+  version_policy = {
+    allowed_branches = ["release/*", "hotfix/*"]
+  }
+}
+
+resource "cycloid_component" "released_only" {
+  organization  = "my-org"
+  project       = cycloid_project.example.name
+  environment   = cycloid_environment.example.name
+  name          = "released-web-app"
+  stack_ref     = "my-org:web-app-stack"
+  use_case      = "production"
+  stack_version = "release/2.1"
+
+  catalog_version_policy = cycloid_catalog_repository.releases.version_policy
+  version_policy = {
+    allowed_branches = ["release/*"]
+  }
+}
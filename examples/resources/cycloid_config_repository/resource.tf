@@ -13,6 +13,9 @@ resource "cycloid_config_repository" "tf_cr_repo" {
   default = false
   url = var.config_repository_url
   branch = var.config_repository_branch
+
+  # Fail the plan early if the credential cannot read the repository
+  validate_connection = true
 }
 
 provider "cycloid" {
@@ -7,6 +7,19 @@ resource "cycloid_credential" "tf_credential" {
   }
 }
 
+# The secrets are sent to Cycloid but never stored in the Terraform state,
+# bump body_version to send new values.
+resource "cycloid_credential" "tf_credential_write_only" {
+  name = "tfcredentialwriteonly"
+  path = "pathcredentialwriteonly"
+  type = "aws"
+  body = {
+    access_key = var.credential_aws_access_key
+  }
+  secret_key_wo = var.credential_aws_secret_key
+  body_version  = 1
+}
+
 provider "cycloid" {
   url                    = var.cycloid_api_url
   jwt                    = var.cycloid_api_key
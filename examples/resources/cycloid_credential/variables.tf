@@ -14,3 +14,13 @@ variable "cycloid_api_url" {
 variable "credential_ssh_key" {
     description = "SSH key to use for the credential"
 }
+
+variable "credential_aws_access_key" {
+    description = "AWS access key to use for the write-only credential"
+}
+
+variable "credential_aws_secret_key" {
+    description = "AWS secret key to use for the write-only credential"
+    sensitive = true
+    ephemeral = true
+}
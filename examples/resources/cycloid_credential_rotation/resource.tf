@@ -0,0 +1,54 @@
+# A new IAM access key is created whenever the rotation date changes, the
+# Cycloid credential follows with a new version once the pipeline using it
+# builds successfully. Creating the resource does not rotate: the source
+# credential is the version 0 until the first key replacement.
+resource "time_rotating" "aws" {
+  rotation_days = 90
+}
+
+resource "aws_iam_access_key" "cycloid" {
+  user = "cycloid"
+
+  lifecycle {
+    replace_triggered_by = [time_rotating.aws]
+  }
+}
+
+resource "cycloid_credential_rotation" "aws" {
+  source_credential_canonical = "aws-production"
+  rotation_trigger            = aws_iam_access_key.cycloid.id
+
+  body = {
+    access_key = aws_iam_access_key.cycloid.id
+  }
+
+  # Write-only: the secret is sent to Cycloid but not stored in the state of
+  # this resource. Requires Terraform 1.11 or later.
+  secret_key_wo = aws_iam_access_key.cycloid.secret
+
+  wait_for_pipeline = {
+    project     = "infrastructure"
+    environment = "production"
+    component   = "network"
+    pipeline    = "infrastructure-production-network"
+    job         = "terraform-plan"
+    timeout     = "1h"
+  }
+}
+
+# Dependents managed by Terraform reference the current credential, so that
+# their next apply does not point them back to the source credential.
+resource "cycloid_external_backend" "aws_state" {
+  purpose              = "remote_tfstate"
+  engine               = "aws_storage"
+  credential_canonical = cycloid_credential_rotation.aws.credential_canonical
+
+  aws_storage = {
+    bucket = "my-terraform-states"
+    region = "eu-west-1"
+  }
+}
+
+output "current_aws_credential" {
+  value = cycloid_credential_rotation.aws.credential_canonical
+}
@@ -23,6 +23,41 @@ resource "cycloid_external_backend" "tf_external_backend" {
   }
 }
 
+resource "cycloid_credential" "tf_credential_elasticsearch" {
+  name = "tfcredentialelasticsearch"
+  path = "pathelasticsearch"
+  type = "elasticsearch"
+  body = {
+    username = "cycloid"
+    password = "z1Zgdskagdajkgfsa"
+  }
+}
+
+# The engine is inferred from the block set, test_connection checks the
+# backend can be reached before creating it
+resource "cycloid_external_backend" "tf_external_backend_logs" {
+  credential_canonical = cycloid_credential.tf_credential_elasticsearch.canonical
+  purpose              = "logs"
+  test_connection      = true
+  elasticsearch_logs = {
+    version = "8"
+    urls    = ["https://elasticsearch.example.com:9200"]
+    sources = jsonencode({
+      app = {
+        prod = {
+          index = "app-prod-*"
+          urls  = ["https://elasticsearch.example.com:9200"]
+          mapping = {
+            host      = "host.name"
+            message   = "message"
+            timestamp = "@timestamp"
+          }
+        }
+      }
+    })
+  }
+}
+
 provider "cycloid" {
   url                    = var.cycloid_api_url
   jwt                    = var.cycloid_api_key
@@ -0,0 +1,10 @@
+# The full set of OIDC group mappings of the organization: any other mapping
+# is deleted on apply.
+resource "cycloid_oidc_group_mappings" "this" {
+  organization = "my-org"
+
+  mappings = {
+    "FOO_OPERATOR" = ["operator", "lead"]
+    "FOO_ADMIN"    = ["admin"]
+  }
+}
@@ -0,0 +1,30 @@
+resource "cycloid_organization_api_key" "deployer" {
+  name        = "deployer"
+  description = "API key of the deployment automation, rotated every 30 days"
+
+  rules = [
+    {
+      action = "organization:pipeline:*"
+      effect = "allow"
+    },
+  ]
+
+  # Rotate every 30 days, and right away when the vault path changes
+  rotate_after_days = 30
+  rotation_triggers = {
+    vault_path = "secret/ci/cycloid"
+  }
+}
+
+# The consumers read the token from the credential, which is updated with the
+# new token when the key is rotated
+resource "cycloid_credential" "deployer_api_key" {
+  name = "deployer-api-key"
+  path = "deployer-api-key"
+  type = "custom"
+  body = {
+    raw = {
+      token = cycloid_organization_api_key.deployer.token
+    }
+  }
+}
@@ -0,0 +1,30 @@
+# Log in only through Azure AD and GitHub Enterprise, local login is disabled.
+#
+# The GitHub client secret is write-only: it is never stored in the state.
+# Bump secrets_version to send a new value.
+
+resource "cycloid_organization_authentication" "this" {
+  organization    = "my-org"
+  secrets_version = 1
+
+  local = {
+    enabled = false
+  }
+
+  azure_ad = {
+    enabled   = true
+    client_id = "00000000-0000-0000-0000-000000000000"
+    tenant_id = "11111111-1111-1111-1111-111111111111"
+  }
+
+  github = {
+    enabled          = true
+    client_id        = "Iv1.0123456789abcdef"
+    host_address     = "https://github.example.com/api/v3"
+    client_secret_wo = var.github_client_secret
+  }
+
+  google = {
+    enabled = false
+  }
+}
@@ -1,6 +1,11 @@
 resource "cycloid_organization_member" "tf_org_member" {
   email = "random.email@host.com"
   role_canonical = "organization-admin"
+
+  # Send the invitation again on apply once it has expired, after the
+  # validity period of the invitations of the Cycloid instance
+  invitation_validity_days  = 7
+  resend_expired_invitation = true
 }
 
 provider "cycloid" {
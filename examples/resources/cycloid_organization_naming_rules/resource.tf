@@ -0,0 +1,14 @@
+# Projects are prefixed by their business unit, environments are limited to a
+# known set. Components have no naming rule.
+resource "cycloid_organization_naming_rules" "this" {
+  organization = "my-org"
+
+  project = {
+    regex   = "^(pay|risk|data)-[a-z0-9-]+$"
+    message = "Project canonicals must start with the business unit: pay-, risk- or data-."
+  }
+
+  environment = {
+    regex = "^(dev|staging|prod)$"
+  }
+}
@@ -0,0 +1,9 @@
+# Enforce MFA and only let invited users log in through SSO.
+resource "cycloid_organization_security_settings" "this" {
+  organization    = "my-org"
+  mfa_enabled     = true
+  sso_invite_only = true
+
+  # Not returned by the API, set it here to keep it on each update
+  creation_restricted_message = "Contact the platform team to create an organization."
+}
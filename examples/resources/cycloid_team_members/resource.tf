@@ -0,0 +1,24 @@
+resource "cycloid_team" "sre" {
+  name  = "SRE"
+  owner = "hannibal"
+  roles = ["organization-admin"]
+}
+
+// The team has exactly these members, the ones added through the console or
+// SSO are removed on the next apply
+resource "cycloid_team_members" "sre" {
+  team         = cycloid_team.sre.canonical
+  organization = cycloid_team.sre.organization
+  emails = [
+    "hannibal@a-team.com",
+    "baracus@a-team.com",
+  ]
+  member_ids = [42]
+}
+
+// Only adds the members to a team shared with other configurations
+resource "cycloid_team_members" "on_call" {
+  team   = "on-call"
+  mode   = "additive"
+  emails = ["murdock@a-team.com"]
+}
@@ -0,0 +1,75 @@
+// Package lhsfilter holds the filters block of the data sources listing
+// entities with LHS filters applied by the API.
+package lhsfilter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+)
+
+// Attribute returns the filters block sent to the API when listing the
+// 'entities'. 'example' is an attribute name the entities can be filtered on
+// and 'details', when not empty, is appended to the description.
+func Attribute(entities, example, details string) schema.ListNestedAttribute {
+	description := "List of LHS filters applied by the API when listing " + entities + "."
+	if details != "" {
+		description += " " + details
+	}
+
+	return schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"attribute": schema.StringAttribute{
+					Required:            true,
+					Description:         "The name of the attribute to filter on, for example \"" + example + "\".",
+					MarkdownDescription: "The name of the attribute to filter on, for example `" + example + "`.",
+				},
+				"condition": schema.StringAttribute{
+					Required:            true,
+					Description:         `The condition to apply, one of "eq", "neq", "gt", "lt", "rlike" or "in".`,
+					MarkdownDescription: `The condition to apply, one of "eq", "neq", "gt", "lt", "rlike" or "in".`,
+					Validators: []validator.String{
+						stringvalidator.OneOf("eq", "neq", "gt", "lt", "rlike", "in"),
+					},
+				},
+				"value": schema.StringAttribute{
+					Required:            true,
+					Description:         "The value of the filter",
+					MarkdownDescription: "The value of the filter",
+				},
+			},
+		},
+		Optional:            true,
+		Description:         description + " See the docs here: https://docs.cycloid.io/reference/api/LHS-filters",
+		MarkdownDescription: description + " See the docs [here](https://docs.cycloid.io/reference/api/LHS-filters)",
+	}
+}
+
+// Filter is an element of the Attribute list.
+type Filter struct {
+	Attribute string `tfsdk:"attribute"`
+	Condition string `tfsdk:"condition"`
+	Value     string `tfsdk:"value"`
+}
+
+// FromList converts the 'list' of Attribute to the filters of the API client.
+func FromList(ctx context.Context, list types.List) ([]apiclient.LHSFilter, diag.Diagnostics) {
+	var filters []Filter
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	diags := list.ElementsAs(ctx, &filters, false)
+
+	lhs := make([]apiclient.LHSFilter, len(filters))
+	for i, f := range filters {
+		lhs[i] = apiclient.LHSFilter{Attribute: f.Attribute, Condition: f.Condition, Value: f.Value}
+	}
+	return lhs, diags
+}
@@ -0,0 +1,223 @@
+// Package policy holds the catalog of the Cycloid policy actions used in the
+// rules of roles and API keys, and how their globs and resources match.
+package policy
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// EffectAllow is the only effect the API supports on a rule.
+const EffectAllow = "allow"
+
+var (
+	crud = []string{"create", "read", "update", "delete"}
+
+	// entities are the entities of the organization with the operations
+	// the API has a policy for
+	entities = map[string][]string{
+		"api_key":               crud,
+		"appearance":            {"read", "update"},
+		"catalog_repository":    append(slices.Clone(crud), "refresh"),
+		"children":              crud,
+		"cloud_account":         crud,
+		"cloud_cost_management": {"read", "update"},
+		"component":             crud,
+		"config_repository":     crud,
+		"credential":            crud,
+		"environment":           crud,
+		"event":                 {"create", "read"},
+		"external_backend":      crud,
+		"infra_policy":          crud,
+		"inventory":             crud,
+		"invitation":            {"create", "read", "delete"},
+		"kpi":                   crud,
+		"licence":               {"read", "update"},
+		"member":                {"read", "update", "delete"},
+		"pipeline":              append(slices.Clone(crud), "pause", "unpause"),
+		"pipeline_build":        {"create", "read", "abort"},
+		"pipeline_job":          {"read", "pause", "unpause"},
+		"plugin":                crud,
+		"project":               crud,
+		"resource_pool":         crud,
+		"role":                  crud,
+		"service_catalog":       crud,
+		"subscription":          {"read", "update"},
+		"team":                  crud,
+		"team_member":           {"create", "read", "delete"},
+	}
+
+	// Actions is the sorted catalog of the known actions.
+	Actions = func() []string {
+		actions := []string{"organization:read", "organization:update", "organization:delete"}
+		for entity, operations := range entities {
+			for _, op := range operations {
+				actions = append(actions, "organization:"+entity+":"+op)
+			}
+		}
+		slices.Sort(actions)
+		return actions
+	}()
+
+	segmentRe = regexp.MustCompile(`^[a-zA-Z0-9_.\-*?]+$`)
+)
+
+// IsGlob returns whether the 'action' has wildcards.
+func IsGlob(action string) bool {
+	return strings.ContainsAny(action, "*?")
+}
+
+// Match returns whether the 'action' matches the 'pattern', a '*' matches
+// within a segment of the action and a '**' segment matches any number of
+// segments.
+func Match(pattern, action string) bool {
+	return matchSegments(strings.Split(pattern, ":"), strings.Split(action, ":"))
+}
+
+func matchSegments(pattern, action []string) bool {
+	if len(pattern) == 0 {
+		return len(action) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(action); i++ {
+			if matchSegments(pattern[1:], action[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(action) == 0 {
+		return false
+	}
+	ok, err := path.Match(pattern[0], action[0])
+	if err != nil || !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], action[1:])
+}
+
+// Expand returns the actions of the catalog matching the 'pattern'.
+func Expand(pattern string) []string {
+	var actions []string
+	for _, a := range Actions {
+		if Match(pattern, a) {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+// ValidateAction returns an error if the 'action' is not in the catalog, or
+// matches none of its actions for a glob.
+func ValidateAction(action string) error {
+	if IsGlob(action) {
+		if len(Expand(action)) == 0 {
+			return fmt.Errorf("the action %q matches none of the known actions", action)
+		}
+		return nil
+	}
+	if !slices.Contains(Actions, action) {
+		return fmt.Errorf("the action %q is not a known action", action)
+	}
+	return nil
+}
+
+// ValidateResource returns an error if the 'resource' is not like
+// organization:<org>[:<entity>:<canonical>]..., where the canonicals
+// support globs.
+func ValidateResource(resource string) error {
+	segments := strings.Split(resource, ":")
+	if len(segments) < 2 || segments[0] != "organization" {
+		return fmt.Errorf("the resource %q must start with organization:<organization_canonical>", resource)
+	}
+	for i, s := range segments[1:] {
+		if !segmentRe.MatchString(s) {
+			return fmt.Errorf("the resource %q has an invalid segment %q", resource, s)
+		}
+		// The segments after the organization go by entity and canonical
+		// pairs, a trailing '**' matches everything below
+		if i%2 == 1 && !(s == "**" && i == len(segments)-2) {
+			if _, ok := entities[s]; !ok {
+				return fmt.Errorf("the resource %q has an unknown entity %q", resource, s)
+			}
+		}
+	}
+	if len(segments)%2 == 1 && segments[len(segments)-1] != "**" {
+		return fmt.Errorf("the resource %q must end with the canonical of its last entity", resource)
+	}
+	return nil
+}
+
+// scopes are the entities, from the organization, the canonicals of an
+// entity are given for. The entities missing are directly below the
+// organization.
+var scopes = map[string][]string{
+	"environment":    {"project", "environment"},
+	"component":      {"project", "environment", "component"},
+	"pipeline":       {"project", "environment", "component"},
+	"pipeline_build": {"project", "environment", "component"},
+	"pipeline_job":   {"project", "environment", "component"},
+}
+
+// Entity returns the entity of the 'action', like project for
+// organization:project:read, or an empty string for the actions on the
+// organization itself, like organization:read.
+func Entity(action string) string {
+	if segments := strings.Split(action, ":"); len(segments) == 3 {
+		return segments[1]
+	}
+	return ""
+}
+
+// Resource returns the resource the 'action' applies to in the 'org' for the
+// 'canonicals' of its entity and the entities above it, like
+// organization:my-org:project:my-project:environment:prod. The canonicals
+// are ignored for the actions on the organization itself.
+func Resource(org, action string, canonicals []string) string {
+	resource := "organization:" + org
+	entity := Entity(action)
+	if len(canonicals) == 0 || entity == "" {
+		return resource
+	}
+
+	scope, ok := scopes[entity]
+	if !ok {
+		scope = []string{entity}
+	}
+
+	for i, c := range canonicals {
+		if i >= len(scope) {
+			break
+		}
+		resource += ":" + scope[i] + ":" + c
+	}
+	return resource
+}
+
+// Rule is a rule of a role, without resources when it is global.
+type Rule struct {
+	Action    string
+	Resources []string
+}
+
+// Allows returns whether one of the 'rules' grants the 'action' on the
+// 'resource'.
+func Allows(rules []Rule, action, resource string) bool {
+	for _, r := range rules {
+		if !Match(r.Action, action) {
+			continue
+		}
+		if len(r.Resources) == 0 {
+			return true
+		}
+		for _, res := range r.Resources {
+			if Match(res, resource) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+// Package versionpolicy holds the stack version policy declared on a catalog
+// repository and checked on the components using its stacks.
+package versionpolicy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Version types, as returned by the API for a stack version.
+const (
+	TypeTag    = "tag"
+	TypeBranch = "branch"
+	TypeCommit = "commit"
+)
+
+// Attribute returns the version_policy schema attribute, description
+// explains where the policy is declared or enforced.
+func Attribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"tags_only": schema.BoolAttribute{
+				Description:         "When true, only tags are accepted as stack version.",
+				MarkdownDescription: "When `true`, only tags are accepted as stack version.",
+				Optional:            true,
+			},
+			"allowed_branches": schema.ListAttribute{
+				Description:         "Glob patterns, like release/*, of the branches accepted as stack version besides tags. When empty, any branch is accepted unless tags_only is set. Commit hashes are only accepted when tags_only is not set.",
+				MarkdownDescription: "Glob patterns, like `release/*`, of the branches accepted as stack version besides tags. When empty, any branch is accepted unless `tags_only` is set. Commit hashes are only accepted when `tags_only` is not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(globValidator{}),
+				},
+			},
+		},
+	}
+}
+
+type Model struct {
+	TagsOnly        types.Bool `tfsdk:"tags_only"`
+	AllowedBranches types.List `tfsdk:"allowed_branches"`
+}
+
+func AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tags_only":        types.BoolType,
+		"allowed_branches": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// Policy is the decoded version policy.
+type Policy struct {
+	TagsOnly        bool
+	AllowedBranches []string
+}
+
+// FromObject decodes a version_policy value, a null or unknown value returns
+// a nil policy.
+func FromObject(ctx context.Context, obj types.Object) (*Policy, error) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var m Model
+	if diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, fmt.Errorf("invalid version_policy: %v", diags)
+	}
+	if m.AllowedBranches.IsUnknown() || m.TagsOnly.IsUnknown() {
+		return nil, nil
+	}
+
+	p := &Policy{TagsOnly: m.TagsOnly.ValueBool()}
+	if !m.AllowedBranches.IsNull() {
+		if diags := m.AllowedBranches.ElementsAs(ctx, &p.AllowedBranches, false); diags.HasError() {
+			return nil, fmt.Errorf("invalid version_policy.allowed_branches: %v", diags)
+		}
+	}
+
+	return p, nil
+}
+
+// Check returns an error explaining why a version of versionType named name
+// is not allowed by the policy.
+func (p *Policy) Check(versionType, name string) error {
+	switch versionType {
+	case TypeTag:
+		return nil
+	case TypeBranch:
+		if p.TagsOnly {
+			return fmt.Errorf("%q is a branch but the version policy only accepts tags", name)
+		}
+		if len(p.AllowedBranches) == 0 || slices.ContainsFunc(p.AllowedBranches, func(pattern string) bool {
+			ok, _ := path.Match(pattern, name)
+			return ok
+		}) {
+			return nil
+		}
+		return fmt.Errorf("branch %q does not match any of the branches allowed by the version policy: %s", name, strings.Join(p.AllowedBranches, ", "))
+	default:
+		if p.TagsOnly {
+			return fmt.Errorf("%q is a commit hash but the version policy only accepts tags", name)
+		}
+		return nil
+	}
+}
+
+type globValidator struct{}
+
+func (v globValidator) Description(ctx context.Context) string {
+	return "value must be a valid glob pattern"
+}
+
+func (v globValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v globValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := path.Match(req.ConfigValue.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid branch pattern", fmt.Sprintf("%q is not a valid glob pattern: %s", req.ConfigValue.ValueString(), err.Error()))
+	}
+}
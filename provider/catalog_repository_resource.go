@@ -19,10 +19,12 @@ import (
 
 	cycloidapiclient "github.com/cycloidio/cycloid-cli/cmd/apiclient"
 	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
 	"github.com/cycloidio/terraform-provider-cycloid/resource_catalog_repository"
 )
 
 var _ resource.Resource = (*catalogRepositoryResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*catalogRepositoryResource)(nil)
 
 func NewCatalogRepositoryResource() resource.Resource {
 	return &catalogRepositoryResource{}
@@ -65,6 +67,13 @@ func (r *catalogRepositoryResource) Schema(ctx context.Context, req resource.Sch
 		Description:         "When true (default), immediately re-indexes all branches and tags for the catalog repository after create or update, instead of waiting for the background cron (~10 min). Set to false to skip the immediate refresh and rely on the background cron instead.",
 		MarkdownDescription: "When `true` (default), immediately re-indexes all branches and tags for the catalog repository after create or update, instead of waiting for the background cron (~10 min). Set to `false` to skip the immediate refresh and rely on the background cron instead.",
 	}
+	resp.Schema.Attributes["validate_connection"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		Description:         "When true, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists. The check runs on create and whenever url, branch or credential_canonical change.",
+		MarkdownDescription: "When `true`, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists. The check runs on create and whenever `url`, `branch` or `credential_canonical` change.",
+	}
 }
 
 func (r *catalogRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -84,6 +93,63 @@ func (r *catalogRepositoryResource) Configure(ctx context.Context, req resource.
 	r.provider = pv
 }
 
+func (r *catalogRepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.provider == nil || r.provider.Client == nil {
+		return
+	}
+
+	var plan catalogRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ValidateConnection.ValueBool() {
+		return
+	}
+
+	var config catalogRepositoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// credential_canonical is optional: public repositories are read without one
+	credential := plan.CredentialCanonical
+	if config.CredentialCanonical.IsNull() {
+		credential = types.StringValue("")
+	}
+
+	conn := repositoryConnection{
+		URL:        plan.Url,
+		Branch:     plan.Branch,
+		Credential: credential,
+	}
+	if !conn.known() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state catalogRepositoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !conn.changed(repositoryConnection{
+			URL:        state.Url,
+			Branch:     state.Branch,
+			Credential: state.CredentialCanonical,
+		}) {
+			return
+		}
+	}
+
+	orgCan := getOrganizationCanonical(*r.provider, plan.OrganizationCanonical)
+	resp.Diagnostics.Append(validateRepositoryConnection(r.provider.Client, orgCan, conn)...)
+}
+
 func (r *catalogRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data catalogRepositoryResourceModel
 
@@ -125,15 +191,25 @@ func (r *catalogRepositoryResource) Create(ctx context.Context, req resource.Cre
 
 	resp.Diagnostics.Append(catalogRepositoryCYModelToData(orgCan, cr, &data)...)
 
+	refreshState, refreshError := catalogRepositoryRefreshNotRequested, ""
+	var versions []*cycloidapiclient.StackVersion
 	if data.RefreshOnCreate.ValueBool() {
-		if err := r.refreshCatalogRepositoryVersions(orgCan, data.Canonical.ValueString()); err != nil {
+		refreshed, err := r.refreshCatalogRepositoryVersions(orgCan, data.Canonical.ValueString())
+		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Unable to refresh catalog repository versions",
 				"The catalog repository was created successfully, but the immediate version refresh failed. "+
 					"Branch versions will be populated by the background cron (~10 min). Error: "+err.Error(),
 			)
+			refreshState, refreshError = catalogRepositoryRefreshFailed, err.Error()
+		} else {
+			refreshState, versions = catalogRepositoryRefreshSucceeded, refreshed
+			cr = r.reloadCatalogRepository(orgCan, cr)
+			resp.Diagnostics.Append(catalogRepositoryCYModelToData(orgCan, cr, &data)...)
 		}
 	}
+	resp.Diagnostics.Append(catalogRepositorySyncStatusToData(cr.RefreshedAt, refreshState, refreshError, &data)...)
+	resp.Diagnostics.Append(r.readCatalogRepositoryVersions(ctx, orgCan, cr, versions, &data)...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -169,6 +245,35 @@ func (r *catalogRepositoryResource) Read(ctx context.Context, req resource.ReadR
 
 	resp.Diagnostics.Append(catalogRepositoryCYModelToData(orgCan, cr, &data)...)
 
+	// The outcome of the last refresh triggered by Terraform is kept, only
+	// refreshed_at is read from the API.
+	refreshState, refreshError := catalogRepositoryRefreshNotRequested, ""
+	if !data.SyncStatus.IsNull() && !data.SyncStatus.IsUnknown() {
+		attrs := data.SyncStatus.Attributes()
+		if priorState, ok := attrs["state"].(types.String); ok && priorState.ValueString() != "" {
+			refreshState = priorState.ValueString()
+		}
+		if priorError, ok := attrs["last_error"].(types.String); ok {
+			refreshError = priorError.ValueString()
+		}
+	}
+	resp.Diagnostics.Append(catalogRepositorySyncStatusToData(cr.RefreshedAt, refreshState, refreshError, &data)...)
+
+	versions, err := listCatalogRepositoryVersions(mid, orgCan, cr)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to list catalog repository versions",
+			fmt.Sprintf("The versions of catalog repository %q are left unchanged: %s", can, err.Error()),
+		)
+	} else {
+		resp.Diagnostics.Append(catalogRepositoryVersionsToData(ctx, versions, &data)...)
+	}
+
+	// State written by older provider versions has no validate_connection
+	if data.ValidateConnection.IsNull() {
+		data.ValidateConnection = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -222,15 +327,25 @@ func (r *catalogRepositoryResource) Update(ctx context.Context, req resource.Upd
 
 	resp.Diagnostics.Append(catalogRepositoryCYModelToData(orgCan, cr, &data)...)
 
+	refreshState, refreshError := catalogRepositoryRefreshNotRequested, ""
+	var versions []*cycloidapiclient.StackVersion
 	if data.RefreshOnCreate.ValueBool() {
-		if err := r.refreshCatalogRepositoryVersions(orgCan, can); err != nil {
+		refreshed, err := r.refreshCatalogRepositoryVersions(orgCan, can)
+		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Unable to refresh catalog repository versions",
 				"The catalog repository was updated successfully, but the immediate version refresh failed. "+
 					"Branch versions will be populated by the background cron (~10 min). Error: "+err.Error(),
 			)
+			refreshState, refreshError = catalogRepositoryRefreshFailed, err.Error()
+		} else {
+			refreshState, versions = catalogRepositoryRefreshSucceeded, refreshed
+			cr = r.reloadCatalogRepository(orgCan, cr)
+			resp.Diagnostics.Append(catalogRepositoryCYModelToData(orgCan, cr, &data)...)
 		}
 	}
+	resp.Diagnostics.Append(catalogRepositorySyncStatusToData(cr.RefreshedAt, refreshState, refreshError, &data)...)
+	resp.Diagnostics.Append(r.readCatalogRepositoryVersions(ctx, orgCan, cr, versions, &data)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -399,10 +514,112 @@ func (r *catalogRepositoryResource) updateCatalogRepository(org, catalogRepo, na
 // for the given catalog repository. This resolves the eventual-consistency race where a freshly
 // created catalog repository has no version rows yet (the background cron that populates them
 // runs every ~10 minutes by default).
-func (r *catalogRepositoryResource) refreshCatalogRepositoryVersions(org, catalogRepo string) error {
+func (r *catalogRepositoryResource) refreshCatalogRepositoryVersions(org, catalogRepo string) ([]*cycloidapiclient.StackVersion, error) {
 	mid := r.provider.Client
-	_, _, err := mid.RefreshCatalogRepositoryVersions(org, catalogRepo)
-	return err
+	versions, _, err := mid.RefreshCatalogRepositoryVersions(org, catalogRepo)
+	return versions, err
+}
+
+// listCatalogRepositoryVersions lists the versions indexed from the catalog
+// repository. The API exposes them through the stacks of the repository, and
+// a version is only listed on the stacks it contains, so the versions of
+// every stack are merged. A repository without stacks has none.
+func listCatalogRepositoryVersions(m cycloidapiclient.APIClient, org string, cr *models.ServiceCatalogSource) ([]*cycloidapiclient.StackVersion, error) {
+	var result []*cycloidapiclient.StackVersion
+	seen := make(map[string]bool)
+	for _, stack := range cr.ServiceCatalogs {
+		if stack == nil || ptr.Value(stack.Ref) == "" {
+			continue
+		}
+		versions, _, err := m.ListStackVersions(org, ptr.Value(stack.Ref))
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			if v == nil {
+				continue
+			}
+			key := ptr.Value(v.Type) + "/" + ptr.Value(v.Name) + "/" + ptr.Value(v.CommitHash)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+func catalogRepositoryVersionsToData(ctx context.Context, versions []*cycloidapiclient.StackVersion, data *catalogRepositoryResourceModel) diag.Diagnostics {
+	items := make([]resource_catalog_repository.VersionItem, 0, len(versions))
+	for _, v := range versions {
+		if v == nil {
+			continue
+		}
+		items = append(items, resource_catalog_repository.VersionItem{
+			CommitHash: types.StringPointerValue(v.CommitHash),
+			IsLatest:   types.BoolValue(ptr.Value(v.IsLatest)),
+			Name:       types.StringPointerValue(v.Name),
+			Status:     types.StringPointerValue(v.Status),
+			Type:       types.StringPointerValue(v.Type),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Versions, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: resource_catalog_repository.VersionAttrTypes()}, items)
+	return diags
+}
+
+// readCatalogRepositoryVersions sets versions after a create or update, from
+// the refresh result when there is one. A failure only warns since the
+// repository itself was saved.
+func (r *catalogRepositoryResource) readCatalogRepositoryVersions(ctx context.Context, org string, cr *models.ServiceCatalogSource, refreshed []*cycloidapiclient.StackVersion, data *catalogRepositoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	versions := refreshed
+	if versions == nil {
+		var err error
+		versions, err = listCatalogRepositoryVersions(r.provider.Client, org, cr)
+		if err != nil {
+			diags.AddWarning(
+				"Unable to list catalog repository versions",
+				"The catalog repository was saved successfully, but its versions could not be listed and will be refreshed on the next plan. Error: "+err.Error(),
+			)
+		}
+	}
+
+	diags.Append(catalogRepositoryVersionsToData(ctx, versions, data)...)
+	return diags
+}
+
+// reloadCatalogRepository reads the catalog repository again after a refresh
+// so refreshed_at and the stacks reflect it. The previous value is kept if
+// the read fails, the next plan refreshes it anyway.
+func (r *catalogRepositoryResource) reloadCatalogRepository(org string, cr *models.ServiceCatalogSource) *models.ServiceCatalogSource {
+	refreshed, _, err := r.provider.Client.GetCatalogRepository(org, ptr.Value(cr.Canonical))
+	if err != nil || refreshed == nil {
+		return cr
+	}
+	return refreshed
+}
+
+// States of sync_status. The API does not report the outcome of the
+// refreshes run by the background cron, so only the refreshes triggered by
+// Terraform through refresh_on_create are tracked.
+const (
+	catalogRepositoryRefreshNotRequested = "not_requested"
+	catalogRepositoryRefreshSucceeded    = "succeeded"
+	catalogRepositoryRefreshFailed       = "failed"
+)
+
+func catalogRepositorySyncStatusToData(refreshedAt *uint64, state, lastError string, data *catalogRepositoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.SyncStatus, diags = types.ObjectValue(resource_catalog_repository.SyncStatusAttrTypes(), map[string]attr.Value{
+		"last_error":   types.StringValue(lastError),
+		"refreshed_at": ptrUint64ToInt64(refreshedAt),
+		"state":        types.StringValue(state),
+	})
+	return diags
 }
 
 func crStacksToListValue(ctx context.Context, stacks []*models.ServiceCatalog) (basetypes.ListValue, diag.Diagnostics) {
@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_catalog_repository_stacks_policy"
+)
+
+var _ resource.Resource = (*catalogRepositoryStacksPolicyResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*catalogRepositoryStacksPolicyResource)(nil)
+
+type catalogRepositoryStacksPolicyResource struct {
+	provider *CycloidProvider
+}
+
+type catalogRepositoryStacksPolicyResourceModel = resource_catalog_repository_stacks_policy.CatalogRepositoryStacksPolicyModel
+
+func NewCatalogRepositoryStacksPolicyResource() resource.Resource {
+	return &catalogRepositoryStacksPolicyResource{}
+}
+
+func (r *catalogRepositoryStacksPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_repository_stacks_policy"
+}
+
+func (r *catalogRepositoryStacksPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_catalog_repository_stacks_policy.CatalogRepositoryStacksPolicyResourceSchema(ctx)
+}
+
+func (r *catalogRepositoryStacksPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+// ModifyPlan projects the policy on the current stacks of the catalog
+// repository, so every stack that drifted from the policy, including one no
+// longer excluded, shows up as a change in the plan.
+func (r *catalogRepositoryStacksPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy, and on create the stacks are only known at apply
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.provider == nil || r.provider.Client == nil {
+		return
+	}
+
+	var plan catalogRepositoryStacksPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Organization.IsUnknown() || plan.CatalogRepositoryCanonical.IsUnknown() ||
+		plan.Visibility.IsUnknown() || plan.Team.IsUnknown() || plan.Exclude.IsUnknown() || plan.Overrides.IsUnknown() {
+		plan.Stacks = types.MapUnknown(types.ObjectType{AttrTypes: resource_catalog_repository_stacks_policy.StackAttrTypes()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("stacks"), plan.Stacks)...)
+		return
+	}
+
+	policy, diags := newStacksPolicy(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, plan.Organization)
+	catalogRepo := plan.CatalogRepositoryCanonical.ValueString()
+
+	stacks, err := listCatalogRepositoryStacks(r.provider.Client, org, catalogRepo)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to list stacks of catalog repository %q in org %q", catalogRepo, org),
+			err.Error(),
+		)
+		return
+	}
+
+	planned := make(map[string]resource_catalog_repository_stacks_policy.StackModel, len(stacks))
+	for _, stack := range stacks {
+		can := ptr.Value(stack.Canonical)
+		if policy.excluded(can) {
+			continue
+		}
+		current := stackToPolicyStackModel(stack)
+		visibility, team := policy.desired(can, current.Visibility.ValueString(), current.Team.ValueString())
+		planned[can] = resource_catalog_repository_stacks_policy.StackModel{
+			Ref:        current.Ref,
+			Visibility: types.StringValue(visibility),
+			Team:       types.StringValue(team),
+		}
+	}
+
+	plan.Stacks, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: resource_catalog_repository_stacks_policy.StackAttrTypes()}, planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("stacks"), plan.Stacks)...)
+}
+
+func (r *catalogRepositoryStacksPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data catalogRepositoryStacksPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *catalogRepositoryStacksPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data catalogRepositoryStacksPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	catalogRepo := data.CatalogRepositoryCanonical.ValueString()
+
+	if _, _, err := r.provider.Client.GetCatalogRepository(org, catalogRepo); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to get catalog repository %q in org %q", catalogRepo, org),
+			err.Error(),
+		)
+		return
+	}
+
+	policy, diags := newStacksPolicy(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stacks, err := listCatalogRepositoryStacks(r.provider.Client, org, catalogRepo)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to list stacks of catalog repository %q in org %q", catalogRepo, org),
+			err.Error(),
+		)
+		return
+	}
+
+	current := make(map[string]resource_catalog_repository_stacks_policy.StackModel, len(stacks))
+	for _, stack := range stacks {
+		can := ptr.Value(stack.Canonical)
+		if policy.excluded(can) {
+			continue
+		}
+		current[can] = stackToPolicyStackModel(stack)
+	}
+
+	data.Organization = types.StringValue(org)
+	data.Stacks, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: resource_catalog_repository_stacks_policy.StackAttrTypes()}, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *catalogRepositoryStacksPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data catalogRepositoryStacksPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The plan holds every stack not excluded when it was computed, stacks
+	// added to the catalog since then are picked up by the next plan so the
+	// result matches the plan.
+	var planned map[string]resource_catalog_repository_stacks_policy.StackModel
+	if !data.Stacks.IsNull() && !data.Stacks.IsUnknown() {
+		resp.Diagnostics.Append(data.Stacks.ElementsAs(ctx, &planned, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *catalogRepositoryStacksPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data catalogRepositoryStacksPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// apply enforces the policy on the stacks of the catalog repository and sets
+// the resulting stacks in data. When planned is not nil, only those stacks,
+// every stack not excluded at plan time, are updated. A stack failing to
+// update does not stop the others.
+func (r *catalogRepositoryStacksPolicyResource) apply(ctx context.Context, data *catalogRepositoryStacksPolicyResourceModel, planned map[string]resource_catalog_repository_stacks_policy.StackModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m := r.provider.Client
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	catalogRepo := data.CatalogRepositoryCanonical.ValueString()
+
+	policy, d := newStacksPolicy(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	stacks, err := listCatalogRepositoryStacks(m, org, catalogRepo)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("failed to list stacks of catalog repository %q in org %q", catalogRepo, org),
+			err.Error(),
+		)
+		return diags
+	}
+
+	result := make(map[string]resource_catalog_repository_stacks_policy.StackModel, len(stacks))
+	found := make(map[string]bool, len(stacks))
+	for _, stack := range stacks {
+		can := ptr.Value(stack.Canonical)
+		found[can] = true
+		if policy.excluded(can) {
+			continue
+		}
+		if planned != nil {
+			if _, ok := planned[can]; !ok {
+				continue
+			}
+		}
+
+		current := stackToPolicyStackModel(stack)
+		result[can] = current
+
+		visibility, team := policy.desired(can, current.Visibility.ValueString(), current.Team.ValueString())
+		if visibility == current.Visibility.ValueString() && team == current.Team.ValueString() {
+			continue
+		}
+
+		updated, _, err := m.UpdateStack(org, ptr.Value(stack.Ref), team, &visibility)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("stacks").AtMapKey(can),
+				fmt.Sprintf("failed to update stack %q", ptr.Value(stack.Ref)),
+				err.Error(),
+			)
+			continue
+		}
+		result[can] = stackToPolicyStackModel(updated)
+	}
+
+	for can := range planned {
+		if !found[can] {
+			diags.AddAttributeError(
+				path.Root("stacks").AtMapKey(can),
+				"Stack no longer in catalog repository",
+				fmt.Sprintf("stack %q was removed from catalog repository %q after the plan was made, run terraform plan again.", can, catalogRepo),
+			)
+		}
+	}
+
+	for can := range policy.overrides {
+		if !found[can] {
+			diags.AddAttributeWarning(
+				path.Root("overrides").AtMapKey(can),
+				"Override for unknown stack",
+				fmt.Sprintf("catalog repository %q has no stack %q, the override is ignored.", catalogRepo, can),
+			)
+		}
+	}
+
+	data.Organization = types.StringValue(org)
+	data.Stacks, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: resource_catalog_repository_stacks_policy.StackAttrTypes()}, result)
+	diags.Append(d...)
+
+	return diags
+}
+
+// stacksPolicy is the configured policy with its sets and maps decoded.
+type stacksPolicy struct {
+	visibility types.String
+	team       types.String
+	exclude    map[string]bool
+	overrides  map[string]resource_catalog_repository_stacks_policy.OverrideModel
+}
+
+func newStacksPolicy(ctx context.Context, data *catalogRepositoryStacksPolicyResourceModel) (*stacksPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := &stacksPolicy{
+		visibility: data.Visibility,
+		team:       data.Team,
+		exclude:    map[string]bool{},
+		overrides:  map[string]resource_catalog_repository_stacks_policy.OverrideModel{},
+	}
+
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
+		var exclude []string
+		diags.Append(data.Exclude.ElementsAs(ctx, &exclude, false)...)
+		for _, can := range exclude {
+			policy.exclude[can] = true
+		}
+	}
+
+	if !data.Overrides.IsNull() && !data.Overrides.IsUnknown() {
+		diags.Append(data.Overrides.ElementsAs(ctx, &policy.overrides, false)...)
+	}
+
+	return policy, diags
+}
+
+func (p *stacksPolicy) excluded(can string) bool {
+	return p.exclude[can]
+}
+
+// desired returns the visibility and team the stack must have, an unset value
+// in both the override and the policy keeps the current one.
+func (p *stacksPolicy) desired(can, visibility, team string) (string, string) {
+	if !p.visibility.IsNull() {
+		visibility = p.visibility.ValueString()
+	}
+	if !p.team.IsNull() {
+		team = p.team.ValueString()
+	}
+
+	if override, ok := p.overrides[can]; ok {
+		if !override.Visibility.IsNull() {
+			visibility = override.Visibility.ValueString()
+		}
+		if !override.Team.IsNull() {
+			team = override.Team.ValueString()
+		}
+	}
+
+	return visibility, team
+}
+
+// listCatalogRepositoryStacks lists the stacks indexed from a catalog repository.
+func listCatalogRepositoryStacks(m apiclient.APIClient, org, catalogRepo string) ([]*models.ServiceCatalog, error) {
+	stacks, _, err := m.ListStacks(org, apiclient.LHSFilter{
+		Attribute: "service_catalog_source_canonical",
+		Condition: "eq",
+		Value:     catalogRepo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Filter again in case the API ignores the filter, a policy must never
+	// touch the stacks of another catalog repository.
+	result := make([]*models.ServiceCatalog, 0, len(stacks))
+	for _, stack := range stacks {
+		if stack == nil || stack.ServiceCatalogSourceCanonical != catalogRepo {
+			continue
+		}
+		result = append(result, stack)
+	}
+
+	return result, nil
+}
+
+func stackToPolicyStackModel(stack *models.ServiceCatalog) resource_catalog_repository_stacks_policy.StackModel {
+	team := ""
+	if stack.Team != nil {
+		team = ptr.Value(stack.Team.Canonical)
+	}
+
+	return resource_catalog_repository_stacks_policy.StackModel{
+		Ref:        types.StringPointerValue(stack.Ref),
+		Visibility: types.StringValue(ptr.Value(stack.Visibility)),
+		Team:       types.StringValue(team),
+	}
+}
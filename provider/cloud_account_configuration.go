@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_cloud_account"
+)
+
+// The client models of the cloud account bodies do not carry the cloud
+// provider configuration, these requests add it.
+
+type newCloudAccountRequest struct {
+	*models.NewCloudAccount
+
+	Configuration models.CloudProviderConfiguration `json:"configuration,omitempty"`
+}
+
+type newCloudAccountWithCredentialsRequest struct {
+	*models.NewCloudAccountWithCredentials
+
+	Configuration models.CloudProviderConfiguration `json:"configuration,omitempty"`
+}
+
+type updateCloudAccountRequest struct {
+	*models.UpdateCloudAccount
+
+	Configuration models.CloudProviderConfiguration `json:"configuration,omitempty"`
+}
+
+// createCloudAccount sends the body to the routes of
+// apiclient.CreateCloudAccount and apiclient.CreateCloudAccountWithCredentials,
+// which only take the client models.
+func createCloudAccount(m apiclient.APIClient, org string, body any, withCredentials bool) error {
+	route := []string{"organizations", org, "cloud_accounts"}
+	if withCredentials {
+		route = append(route, "with_credentials")
+	}
+
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "POST",
+		Organization: &org,
+		Route:        route,
+		Body:         body,
+	}, nil)
+	return err
+}
+
+// updateCloudAccount sends the body to the route of
+// apiclient.UpdateCloudAccount, which only takes the client model.
+func updateCloudAccount(m apiclient.APIClient, org, canonical string, body updateCloudAccountRequest) error {
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "PUT",
+		Organization: &org,
+		Route:        []string{"organizations", org, "cloud_accounts", canonical},
+		Body:         body,
+	}, nil)
+	return err
+}
+
+// getCloudProviderConfiguration reads the configuration of the cloud account,
+// nil when the API returns none: it only does for the providers supporting
+// one. It is the route of apiclient.GetCloudAccount, whose
+// models.CloudAccountDetail drops the configuration.
+func getCloudProviderConfiguration(m apiclient.APIClient, org, canonical string) (models.CloudProviderConfiguration, error) {
+	var result struct {
+		Configuration json.RawMessage `json:"configuration"`
+	}
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org, "cloud_accounts", canonical},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Configuration) == 0 || string(result.Configuration) == "null" {
+		return nil, nil
+	}
+
+	configuration, err := models.UnmarshalCloudProviderConfiguration(bytes.NewReader(result.Configuration), runtime.JSONConsumer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cloud account configuration: %w", err)
+	}
+	return configuration, nil
+}
+
+// readCloudProviderConfiguration sets configuration from the API. The
+// configuration is only managed when it is set: a null configuration is
+// kept null, and so is a cloud account returned without configuration.
+func readCloudProviderConfiguration(ctx context.Context, m apiclient.APIClient, org, canonical string, data *cloudAccountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Configuration.IsNull() || data.Configuration.IsUnknown() {
+		data.Configuration = types.ObjectNull(resource_cloud_account.ConfigurationAttrTypes())
+		return diags
+	}
+
+	configuration, err := getCloudProviderConfiguration(m, org, canonical)
+	if err != nil {
+		diags.AddError("failed to read cloud account configuration", err.Error())
+		return diags
+	}
+	if configuration == nil {
+		return diags
+	}
+
+	current, diags := cloudProviderConfigurationToData(ctx, configuration)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Configuration, diags = keepUnsetConfigurationFields(ctx, data.Configuration, current)
+	return diags
+}
+
+// keepUnsetConfigurationFields keeps null the optional fields that prior does
+// not set, the API returns them with their default value.
+func keepUnsetConfigurationFields(ctx context.Context, prior, current types.Object) (types.Object, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	var p, c resource_cloud_account.ConfigurationModel
+	diags.Append(prior.As(ctx, &p, basetypes.ObjectAsOptions{})...)
+	diags.Append(current.As(ctx, &c, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return current, diags
+	}
+
+	if !p.Azure.IsNull() && !c.Azure.IsNull() && p.Azure.Attributes()["resource_group_names"].IsNull() {
+		attrs := c.Azure.Attributes()
+		if groups, ok := attrs["resource_group_names"].(types.List); ok && len(groups.Elements()) == 0 {
+			attrs["resource_group_names"] = types.ListNull(types.StringType)
+			c.Azure, d = types.ObjectValue(resource_cloud_account.AzureConfigurationAttrTypes(), attrs)
+			diags.Append(d...)
+		}
+	}
+
+	if !p.VSphere.IsNull() && !c.VSphere.IsNull() && p.VSphere.Attributes()["allow_unverified_ssl"].IsNull() {
+		attrs := c.VSphere.Attributes()
+		if allow, ok := attrs["allow_unverified_ssl"].(types.Bool); ok && !allow.ValueBool() {
+			attrs["allow_unverified_ssl"] = types.BoolNull()
+			c.VSphere, d = types.ObjectValue(resource_cloud_account.VSphereConfigurationAttrTypes(), attrs)
+			diags.Append(d...)
+		}
+	}
+	if diags.HasError() {
+		return current, diags
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, resource_cloud_account.ConfigurationAttrTypes(), c)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func cloudProviderConfigurationFromData(ctx context.Context, obj types.Object) (models.CloudProviderConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+
+	var conf resource_cloud_account.ConfigurationModel
+	diags.Append(obj.As(ctx, &conf, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch {
+	case !conf.AWS.IsNull():
+		var aws resource_cloud_account.AWSConfigurationModel
+		diags.Append(conf.AWS.As(ctx, &aws, basetypes.ObjectAsOptions{})...)
+		return &models.CloudProviderAWSConfiguration{Region: aws.Region.ValueStringPointer()}, diags
+	case !conf.GCP.IsNull():
+		var gcp resource_cloud_account.GCPConfigurationModel
+		diags.Append(conf.GCP.As(ctx, &gcp, basetypes.ObjectAsOptions{})...)
+		return &models.CloudProviderGCPConfiguration{Project: gcp.Project.ValueStringPointer(), Region: gcp.Region.ValueStringPointer()}, diags
+	case !conf.Azure.IsNull():
+		var azure resource_cloud_account.AzureConfigurationModel
+		diags.Append(conf.Azure.As(ctx, &azure, basetypes.ObjectAsOptions{})...)
+		c := &models.CloudProviderAzureConfiguration{Environment: azure.Environment.ValueStringPointer(), ResourceGroupNames: []string{}}
+		if !azure.ResourceGroupNames.IsNull() {
+			diags.Append(azure.ResourceGroupNames.ElementsAs(ctx, &c.ResourceGroupNames, false)...)
+		}
+		return c, diags
+	case !conf.VSphere.IsNull():
+		var vsphere resource_cloud_account.VSphereConfigurationModel
+		diags.Append(conf.VSphere.As(ctx, &vsphere, basetypes.ObjectAsOptions{})...)
+		allow := vsphere.AllowUnverifiedSsl.ValueBool()
+		return &models.CloudProviderVMWareVSphereConfiguration{Server: vsphere.Server.ValueStringPointer(), AllowUnverifiedSsl: &allow}, diags
+	}
+
+	return nil, diags
+}
+
+func cloudProviderConfigurationToData(ctx context.Context, configuration models.CloudProviderConfiguration) (types.Object, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	values := map[string]attr.Value{
+		"aws":     types.ObjectNull(resource_cloud_account.AWSConfigurationAttrTypes()),
+		"gcp":     types.ObjectNull(resource_cloud_account.GCPConfigurationAttrTypes()),
+		"azure":   types.ObjectNull(resource_cloud_account.AzureConfigurationAttrTypes()),
+		"vsphere": types.ObjectNull(resource_cloud_account.VSphereConfigurationAttrTypes()),
+	}
+
+	switch c := configuration.(type) {
+	case *models.CloudProviderAWSConfiguration:
+		values["aws"], d = types.ObjectValue(resource_cloud_account.AWSConfigurationAttrTypes(), map[string]attr.Value{
+			"region": types.StringPointerValue(c.Region),
+		})
+	case *models.CloudProviderGCPConfiguration:
+		values["gcp"], d = types.ObjectValue(resource_cloud_account.GCPConfigurationAttrTypes(), map[string]attr.Value{
+			"project": types.StringPointerValue(c.Project),
+			"region":  types.StringPointerValue(c.Region),
+		})
+	case *models.CloudProviderAzureConfiguration:
+		var groups types.List
+		groups, d = types.ListValueFrom(ctx, types.StringType, c.ResourceGroupNames)
+		diags.Append(d...)
+		values["azure"], d = types.ObjectValue(resource_cloud_account.AzureConfigurationAttrTypes(), map[string]attr.Value{
+			"environment":          types.StringPointerValue(c.Environment),
+			"resource_group_names": groups,
+		})
+	case *models.CloudProviderVMWareVSphereConfiguration:
+		values["vsphere"], d = types.ObjectValue(resource_cloud_account.VSphereConfigurationAttrTypes(), map[string]attr.Value{
+			"server":               types.StringPointerValue(c.Server),
+			"allow_unverified_ssl": types.BoolPointerValue(c.AllowUnverifiedSsl),
+		})
+	default:
+		d.AddError("unsupported cloud account configuration", fmt.Sprintf("The API returned a configuration of type %q which this provider does not support.", configuration.Type()))
+	}
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(resource_cloud_account.ConfigurationAttrTypes()), diags
+	}
+
+	obj, d := types.ObjectValue(resource_cloud_account.ConfigurationAttrTypes(), values)
+	diags.Append(d...)
+	return obj, diags
+}
+
+// cloudAccountCredentialFromData builds the credential created along with
+// the cloud account, named after the cloud account unless set. obj must come
+// from the config so that it holds the write-only secrets.
+func cloudAccountCredentialFromData(ctx context.Context, obj types.Object, cloudAccountName string) (*models.NewCloudAccountCredential, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var cred resource_cloud_account.CredentialModel
+	diags.Append(obj.As(ctx, &cred, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The body has the same attributes as the one of cycloid_credential_rotation
+	rawCred, d := credentialRotationBodyToRaw(ctx, cred.Body)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	_, d = applyCredentialWriteOnly(ctx, credentialResourceModel{
+		ClientSecretWo: cred.ClientSecretWo,
+		JsonKeyWo:      cred.JsonKeyWo,
+		PasswordWo:     cred.PasswordWo,
+		RawWo:          cred.RawWo,
+		SecretKeyWo:    cred.SecretKeyWo,
+		SshKeyWo:       cred.SshKeyWo,
+	}, rawCred)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if cred.Type.ValueString() != "custom" {
+		rawCred.Raw = nil
+	}
+
+	name := Coalesce(cred.Name.ValueString(), cloudAccountName)
+	return &models.NewCloudAccountCredential{
+		Name:        &name,
+		Canonical:   cred.Canonical.ValueString(),
+		Path:        cred.Path.ValueString(),
+		Description: cred.Description.ValueString(),
+		Type:        cred.Type.ValueStringPointer(),
+		Raw:         rawCred,
+	}, diags
+}
@@ -69,17 +69,48 @@ func (r *cloudAccountResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	body := &models.NewCloudAccount{
-		Name:                &name,
-		Canonical:           canonical,
-		CloudProvider:       data.CloudProvider.ValueStringPointer(),
-		CredentialCanonical: data.CredentialCanonical.ValueStringPointer(),
-		Description:         data.Description.ValueString(),
-		Owner:               data.Owner.ValueString(),
+	configuration, diags := cloudProviderConfigurationFromData(ctx, data.Configuration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	m := r.provider.Client
-	_, _, err = m.CreateCloudAccount(org, body)
+	if data.Credential.IsNull() {
+		body := &models.NewCloudAccount{
+			Name:                &name,
+			Canonical:           canonical,
+			CloudProvider:       data.CloudProvider.ValueStringPointer(),
+			CredentialCanonical: data.CredentialCanonical.ValueStringPointer(),
+			Description:         data.Description.ValueString(),
+			Owner:               data.Owner.ValueString(),
+		}
+		err = createCloudAccount(m, org, newCloudAccountRequest{NewCloudAccount: body, Configuration: configuration}, false)
+	} else {
+		var config cloudAccountResourceModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		credential, diags := cloudAccountCredentialFromData(ctx, config.Credential, name)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The credential and the cloud account are created in a single call,
+		// so neither is left behind if the other is rejected
+		body := &models.NewCloudAccountWithCredentials{
+			AccessCredential: credential,
+			Name:             &name,
+			Canonical:        canonical,
+			CloudProvider:    data.CloudProvider.ValueStringPointer(),
+			Description:      data.Description.ValueString(),
+			Owner:            data.Owner.ValueString(),
+		}
+		err = createCloudAccount(m, org, newCloudAccountWithCredentialsRequest{NewCloudAccountWithCredentials: body, Configuration: configuration}, true)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create cloud account", err.Error())
 		return
@@ -93,6 +124,7 @@ func (r *cloudAccountResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	cloudAccountCYModelToData(org, ca, &data)
+	resp.Diagnostics.Append(readCloudProviderConfiguration(ctx, m, org, canonical, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -118,6 +150,7 @@ func (r *cloudAccountResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	cloudAccountCYModelToData(org, ca, &data)
+	resp.Diagnostics.Append(readCloudProviderConfiguration(ctx, r.provider.Client, org, canonical, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -146,8 +179,14 @@ func (r *cloudAccountResource) Update(ctx context.Context, req resource.UpdateRe
 		Owner:               data.Owner.ValueString(),
 	}
 
+	configuration, diags := cloudProviderConfigurationFromData(ctx, data.Configuration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	m := r.provider.Client
-	_, _, err := m.UpdateCloudAccount(org, canonical, body)
+	err := updateCloudAccount(m, org, canonical, updateCloudAccountRequest{UpdateCloudAccount: body, Configuration: configuration})
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update cloud account", err.Error())
 		return
@@ -160,6 +199,7 @@ func (r *cloudAccountResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	cloudAccountCYModelToData(org, ca, &data)
+	resp.Diagnostics.Append(readCloudProviderConfiguration(ctx, m, org, canonical, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -0,0 +1,453 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_cloud_cost_management_account"
+)
+
+var (
+	_ resource.Resource                = (*cloudCostManagementAccountResource)(nil)
+	_ resource.ResourceWithImportState = (*cloudCostManagementAccountResource)(nil)
+)
+
+func NewCloudCostManagementAccountResource() resource.Resource {
+	return &cloudCostManagementAccountResource{}
+}
+
+type cloudCostManagementAccountResource struct {
+	provider *CycloidProvider
+}
+
+type cloudCostManagementAccountResourceModel resource_cloud_cost_management_account.CloudCostManagementAccountModel
+
+// The external backends of the Cloud Cost Management accounts always have
+// this purpose.
+const costExplorerPurpose = "cost_explorer"
+
+func (r *cloudCostManagementAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_cost_management_account"
+}
+
+func (r *cloudCostManagementAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_cloud_cost_management_account.CloudCostManagementAccountResourceSchema(ctx)
+}
+
+func (r *cloudCostManagementAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+	r.provider = pv
+}
+
+func (r *cloudCostManagementAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cloudCostManagementAccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	cloudAccount := data.CloudAccountCanonical.ValueString()
+
+	configuration, diags := costExportConfigurationFromData(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purpose := costExplorerPurpose
+	eb := &models.NewExternalBackend{
+		Purpose:             &purpose,
+		CredentialCanonical: data.CredentialCanonical.ValueString(),
+	}
+	eb.SetConfiguration(configuration)
+
+	enabled := true
+	m := r.provider.Client
+	err := updateCloudAccountFinops(m, org, cloudAccount, &models.UpdateCloudAccountFinops{
+		FinopsEnabled: &enabled,
+		FinopsConfig: &models.NewCloudCostManagementAccount{
+			Canonical:       data.Canonical.ValueString(),
+			Name:            data.Name.ValueString(),
+			ExternalBackend: eb,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to enable FinOps on the cloud account", err.Error())
+		return
+	}
+
+	account, err := getCloudCostManagementAccount(m, org, cloudAccount)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read cloud cost management account after creation", err.Error())
+		return
+	}
+	if account == nil {
+		resp.Diagnostics.AddError("failed to read cloud cost management account after creation", fmt.Sprintf("FinOps is not enabled on the cloud account %q", cloudAccount))
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyLinkedAccountNames(ctx, org, &data)...)
+	resp.Diagnostics.Append(cloudCostManagementAccountCYModelToData(ctx, m, org, account, &data, false)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudCostManagementAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cloudCostManagementAccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	cloudAccount := data.CloudAccountCanonical.ValueString()
+
+	account, err := getCloudCostManagementAccount(r.provider.Client, org, cloudAccount)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read cloud cost management account", err.Error())
+		return
+	}
+	if account == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(cloudCostManagementAccountCYModelToData(ctx, r.provider.Client, org, account, &data, true)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudCostManagementAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, stateData cloudCostManagementAccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	cloudAccount := data.CloudAccountCanonical.ValueString()
+
+	configuration, diags := costExportConfigurationFromData(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purpose := costExplorerPurpose
+	eb := &models.UpdateExternalBackend{
+		Purpose:             &purpose,
+		CredentialCanonical: data.CredentialCanonical.ValueString(),
+	}
+	eb.SetConfiguration(configuration)
+
+	// The body is a models.UpdateCloudCostManagementAccount. The vendored
+	// apiclient has no function for this route, so it is not checked against
+	// the API spec: move it to apiclient once cycloid-cli has it.
+	m := r.provider.Client
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "PUT",
+		Organization: &org,
+		Route:        []string{"organizations", org, "cloud_cost_management", "accounts", stateData.Canonical.ValueString()},
+		Body: &models.UpdateCloudCostManagementAccount{
+			Name:            data.Name.ValueString(),
+			ExternalBackend: eb,
+		},
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update cloud cost management account", err.Error())
+		return
+	}
+
+	account, err := getCloudCostManagementAccount(m, org, cloudAccount)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read cloud cost management account after update", err.Error())
+		return
+	}
+	if account == nil {
+		resp.Diagnostics.AddError("failed to read cloud cost management account after update", fmt.Sprintf("FinOps is not enabled on the cloud account %q", cloudAccount))
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyLinkedAccountNames(ctx, org, &data)...)
+	resp.Diagnostics.Append(cloudCostManagementAccountCYModelToData(ctx, m, org, account, &data, false)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudCostManagementAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data cloudCostManagementAccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	enabled := false
+	err := updateCloudAccountFinops(r.provider.Client, org, data.CloudAccountCanonical.ValueString(), &models.UpdateCloudAccountFinops{
+		FinopsEnabled: &enabled,
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("failed to disable FinOps on the cloud account", err.Error())
+	}
+}
+
+func (r *cloudCostManagementAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cloud_account_canonical"), req, resp)
+}
+
+// applyLinkedAccountNames renames the linked accounts listed on
+// linked_account_names. Accounts not discovered yet are skipped with a
+// warning, Read drops them from the state so they are renamed by a later
+// apply.
+func (r *cloudCostManagementAccountResource) applyLinkedAccountNames(ctx context.Context, org string, data *cloudCostManagementAccountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.LinkedAccountNames.IsNull() || data.LinkedAccountNames.IsUnknown() {
+		return diags
+	}
+
+	names := make(map[string]string)
+	diags.Append(data.LinkedAccountNames.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	linked, err := listCloudCostManagementLinkedAccounts(r.provider.Client, org)
+	if err != nil {
+		diags.AddError("failed to list linked accounts", err.Error())
+		return diags
+	}
+
+	byAccountID := make(map[string]*models.CloudCostManagementLinkedAccount)
+	for _, la := range linked {
+		byAccountID[ptr.Value(la.AccountID)] = la
+	}
+
+	for accountID, name := range names {
+		la, ok := byAccountID[accountID]
+		if !ok {
+			diags.AddWarning(
+				"linked account not found",
+				fmt.Sprintf("The linked account %q has not been discovered by the ingestion yet, it will be renamed by a later apply.", accountID),
+			)
+			continue
+		}
+		if ptr.Value(la.Name) == name {
+			continue
+		}
+
+		// The body is a models.UpdateCloudCostManagementLinkedAccount. The
+		// vendored apiclient has no function for this route, so it is not
+		// checked against the API spec: move it to apiclient once
+		// cycloid-cli has it.
+		_, err := r.provider.Client.GenericRequest(apiclient.Request{
+			Method:       "PUT",
+			Organization: &org,
+			Route:        []string{"organizations", org, "cloud_cost_management", "linked_accounts", strconv.FormatUint(uint64(ptr.Value(la.ID)), 10)},
+			Body:         &models.UpdateCloudCostManagementLinkedAccount{Name: &name},
+		}, nil)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to rename linked account %q", accountID), err.Error())
+		}
+	}
+
+	return diags
+}
+
+// updateCloudAccountFinops enables or disables FinOps on the cloud account.
+// The vendored apiclient has no function for this route, so it is not
+// checked against the API spec: move it to apiclient once cycloid-cli has it.
+func updateCloudAccountFinops(m apiclient.APIClient, org, cloudAccount string, body *models.UpdateCloudAccountFinops) error {
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "PUT",
+		Organization: &org,
+		Route:        []string{"organizations", org, "cloud_accounts", cloudAccount, "finops"},
+		Body:         body,
+	}, nil)
+	return err
+}
+
+// getCloudCostManagementAccount returns the Cloud Cost Management account of
+// the cloud account, nil when FinOps is not enabled on it.
+func getCloudCostManagementAccount(m apiclient.APIClient, org, cloudAccount string) (*models.CloudCostManagementAccount, error) {
+	ca, _, err := m.GetCloudAccount(org, cloudAccount)
+	if err != nil {
+		return nil, err
+	}
+	if !ca.FinopsEnabled {
+		return nil, nil
+	}
+	return ca.FinopsAccount, nil
+}
+
+// listCloudCostManagementLinkedAccounts lists the linked accounts discovered
+// by the ingestion. The vendored apiclient has no function for this route,
+// so it is not checked against the API spec: move it to apiclient once
+// cycloid-cli has it.
+func listCloudCostManagementLinkedAccounts(m apiclient.APIClient, org string) ([]*models.CloudCostManagementLinkedAccount, error) {
+	var result []*models.CloudCostManagementLinkedAccount
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org, "cloud_cost_management", "linked_accounts"},
+	}, &result)
+	return result, err
+}
+
+func costExportConfigurationFromData(ctx context.Context, data cloudCostManagementAccountResourceModel) (models.ExternalBackendConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !data.AwsCur.IsNull():
+		var cur resource_cloud_cost_management_account.AwsCurModel
+		diags.Append(data.AwsCur.As(ctx, &cur, basetypes.ObjectAsOptions{})...)
+		return &models.AWSStorage{
+			Bucket: cur.Bucket.ValueStringPointer(),
+			Region: cur.Region.ValueStringPointer(),
+			Key:    cur.Prefix.ValueString(),
+		}, diags
+	case !data.GcpBillingExport.IsNull():
+		var export resource_cloud_cost_management_account.GcpBillingExportModel
+		diags.Append(data.GcpBillingExport.As(ctx, &export, basetypes.ObjectAsOptions{})...)
+		return &models.GCPCostStorage{
+			ProjectID: export.ProjectID.ValueString(),
+			Dataset:   export.Dataset.ValueString(),
+			Table:     export.Table.ValueString(),
+		}, diags
+	case !data.AzureCostExport.IsNull():
+		var export resource_cloud_cost_management_account.AzureCostExportModel
+		diags.Append(data.AzureCostExport.As(ctx, &export, basetypes.ObjectAsOptions{})...)
+		return &models.AzureCostExport{
+			BlobServiceURL: export.BlobServiceURL.ValueString(),
+			Name:           export.Name.ValueString(),
+			Scope:          export.Scope.ValueString(),
+		}, diags
+	}
+
+	diags.AddError("missing cost export", "One of aws_cur, gcp_billing_export and azure_cost_export must be set.")
+	return nil, diags
+}
+
+// cloudCostManagementAccountCYModelToData converts the 'account' into the
+// 'data', with dropMissingNames the linked_account_names of accounts not
+// discovered yet are removed.
+func cloudCostManagementAccountCYModelToData(ctx context.Context, m apiclient.APIClient, org string, account *models.CloudCostManagementAccount, data *cloudCostManagementAccountResourceModel, dropMissingNames bool) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Organization = types.StringValue(org)
+	data.Name = types.StringPointerValue(account.Name)
+	data.Canonical = types.StringPointerValue(account.Canonical)
+	data.AccountID = types.StringPointerValue(account.AccountID)
+	data.Status = types.StringPointerValue(account.Status)
+	data.StatusMessage = types.StringValue(account.StatusMessage)
+	data.LastIngestionEndedAt = ptrUint64ToInt64(account.LastIngestionEndedAt)
+	data.ID = ptrUint32ToInt64(account.ID)
+
+	data.AwsCur = types.ObjectNull(resource_cloud_cost_management_account.AwsCurAttrTypes())
+	data.GcpBillingExport = types.ObjectNull(resource_cloud_cost_management_account.GcpBillingExportAttrTypes())
+	data.AzureCostExport = types.ObjectNull(resource_cloud_cost_management_account.AzureCostExportAttrTypes())
+	if eb := account.ExternalBackend; eb != nil {
+		data.CredentialCanonical = types.StringValue(eb.CredentialCanonical)
+
+		switch c := eb.Configuration().(type) {
+		case *models.AWSStorage:
+			prefix := types.StringNull()
+			if c.Key != "" {
+				prefix = types.StringValue(c.Key)
+			}
+			data.AwsCur, d = types.ObjectValue(resource_cloud_cost_management_account.AwsCurAttrTypes(), map[string]attr.Value{
+				"bucket": types.StringPointerValue(c.Bucket),
+				"region": types.StringPointerValue(c.Region),
+				"prefix": prefix,
+			})
+		case *models.GCPCostStorage:
+			data.GcpBillingExport, d = types.ObjectValue(resource_cloud_cost_management_account.GcpBillingExportAttrTypes(), map[string]attr.Value{
+				"project_id": types.StringValue(c.ProjectID),
+				"dataset":    types.StringValue(c.Dataset),
+				"table":      types.StringValue(c.Table),
+			})
+		case *models.AzureCostExport:
+			data.AzureCostExport, d = types.ObjectValue(resource_cloud_cost_management_account.AzureCostExportAttrTypes(), map[string]attr.Value{
+				"blob_service_url": types.StringValue(c.BlobServiceURL),
+				"name":             types.StringValue(c.Name),
+				"scope":            types.StringValue(c.Scope),
+			})
+		default:
+			d.AddError("unsupported cost export", fmt.Sprintf("The cost export engine %q is not supported by this provider.", eb.Configuration().Engine()))
+		}
+		diags.Append(d...)
+	} else if data.CredentialCanonical.IsUnknown() {
+		data.CredentialCanonical = types.StringNull()
+	}
+
+	linked, err := listCloudCostManagementLinkedAccounts(m, org)
+	if err != nil {
+		diags.AddError("failed to list linked accounts", err.Error())
+		return diags
+	}
+
+	var cloudProvider string
+	if account.CloudProvider != nil {
+		cloudProvider = ptr.Value(account.CloudProvider.Canonical)
+	}
+
+	items := make([]attr.Value, 0, len(linked))
+	names := make(map[string]attr.Value)
+	for _, la := range linked {
+		if cloudProvider != "" && ptr.Value(la.CloudProvider) != cloudProvider {
+			continue
+		}
+		item, d := types.ObjectValue(resource_cloud_cost_management_account.LinkedAccountAttrTypes(), map[string]attr.Value{
+			"id":             ptrUint32ToInt64(la.ID),
+			"account_id":     types.StringPointerValue(la.AccountID),
+			"cloud_provider": types.StringPointerValue(la.CloudProvider),
+			"name":           types.StringPointerValue(la.Name),
+		})
+		diags.Append(d...)
+		items = append(items, item)
+		names[ptr.Value(la.AccountID)] = types.StringPointerValue(la.Name)
+	}
+	data.LinkedAccounts, d = types.ListValue(types.ObjectType{AttrTypes: resource_cloud_cost_management_account.LinkedAccountAttrTypes()}, items)
+	diags.Append(d...)
+
+	if dropMissingNames && !data.LinkedAccountNames.IsNull() {
+		current := make(map[string]attr.Value)
+		for accountID := range data.LinkedAccountNames.Elements() {
+			if name, ok := names[accountID]; ok {
+				current[accountID] = name
+			}
+		}
+		data.LinkedAccountNames, d = types.MapValue(types.StringType, current)
+		diags.Append(d...)
+	}
+
+	return diags
+}
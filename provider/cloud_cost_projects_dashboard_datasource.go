@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_cloud_cost_projects_dashboard"
+)
+
+var _ datasource.DataSource = (*cloudCostProjectsDashboardDataSource)(nil)
+
+type cloudCostProjectsDashboardDataSource struct {
+	provider *CycloidProvider
+}
+
+type cloudCostProjectsDashboardDatasourceModel = datasource_cloud_cost_projects_dashboard.CloudCostProjectsDashboardModel
+
+func NewCloudCostProjectsDashboardDataSource() datasource.DataSource {
+	return &cloudCostProjectsDashboardDataSource{}
+}
+
+func (s *cloudCostProjectsDashboardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_cost_projects_dashboard"
+}
+
+func (s *cloudCostProjectsDashboardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_cloud_cost_projects_dashboard.CloudCostProjectsDashboardDataSourceSchema(ctx)
+}
+
+func (s *cloudCostProjectsDashboardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *cloudCostProjectsDashboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data cloudCostProjectsDashboardDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var dashboard models.CloudCostManagementProjectsDashboard
+	resp.Diagnostics.Append(getCloudCostDashboard(ctx, s.provider, &data.FilterModel, "projects_dashboard", &dashboard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var d diag.Diagnostics
+	data.ProjectsHistogram, d = cloudCostHistogramToData(dashboard.Projects)
+	resp.Diagnostics.Append(d...)
+	data.ProjectProvidersHistogram, d = cloudCostHistogramToData(dashboard.ProjectProviders)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_cloud_cost_tag_mapping"
+)
+
+var (
+	_ resource.Resource                = (*cloudCostTagMappingResource)(nil)
+	_ resource.ResourceWithImportState = (*cloudCostTagMappingResource)(nil)
+)
+
+func NewCloudCostTagMappingResource() resource.Resource {
+	return &cloudCostTagMappingResource{}
+}
+
+type cloudCostTagMappingResource struct {
+	provider *CycloidProvider
+}
+
+type cloudCostTagMappingResourceModel resource_cloud_cost_tag_mapping.CloudCostTagMappingModel
+
+func (r *cloudCostTagMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_cost_tag_mapping"
+}
+
+func (r *cloudCostTagMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_cloud_cost_tag_mapping.CloudCostTagMappingResourceSchema(ctx)
+}
+
+func (r *cloudCostTagMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+	r.provider = pv
+}
+
+func (r *cloudCostTagMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cloudCostTagMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudCostTagMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cloudCostTagMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	tm, err := getCloudCostTagMapping(r.provider.Client, org)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read cloud cost tag mapping", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(cloudCostTagMappingCYModelToData(ctx, org, tm, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudCostTagMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data cloudCostTagMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudCostTagMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data cloudCostTagMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	// The tag mapping can not be removed, reset it instead
+	_, err := putCloudCostTagMapping(r.provider.Client, org, &models.UpdateCloudCostManagementTagMapping{
+		ProjectTags:     []string{},
+		EnvironmentTags: []string{},
+		ComponentTags:   []string{},
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("failed to reset cloud cost tag mapping", err.Error())
+	}
+}
+
+func (r *cloudCostTagMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization"), req, resp)
+}
+
+func (r *cloudCostTagMappingResource) put(ctx context.Context, data *cloudCostTagMappingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	body := &models.UpdateCloudCostManagementTagMapping{
+		ProjectTags:      []string{},
+		ProjectRegex:     data.ProjectRegex.ValueString(),
+		EnvironmentTags:  []string{},
+		EnvironmentRegex: data.EnvironmentRegex.ValueString(),
+		ComponentTags:    []string{},
+		ComponentRegex:   data.ComponentRegex.ValueString(),
+	}
+	for _, l := range []struct {
+		list types.List
+		tags *[]string
+	}{
+		{data.ProjectTags, &body.ProjectTags},
+		{data.EnvironmentTags, &body.EnvironmentTags},
+		{data.ComponentTags, &body.ComponentTags},
+	} {
+		if !l.list.IsNull() {
+			diags.Append(l.list.ElementsAs(ctx, l.tags, false)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	tm, err := putCloudCostTagMapping(r.provider.Client, org, body)
+	if err != nil {
+		diags.AddError("failed to update cloud cost tag mapping", err.Error())
+		return diags
+	}
+
+	diags.Append(cloudCostTagMappingCYModelToData(ctx, org, tm, data)...)
+	return diags
+}
+
+// getCloudCostTagMapping and putCloudCostTagMapping read and replace the tag
+// mapping of the organization. The vendored apiclient has no function
+// for this route, so it is not checked against the API spec: move it to
+// apiclient once cycloid-cli has it.
+func getCloudCostTagMapping(m apiclient.APIClient, org string) (*models.CloudCostManagementTagMapping, error) {
+	var result *models.CloudCostManagementTagMapping
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org, "cloud_cost_management", "tag_mapping"},
+	}, &result)
+	return result, err
+}
+
+func putCloudCostTagMapping(m apiclient.APIClient, org string, body *models.UpdateCloudCostManagementTagMapping) (*models.CloudCostManagementTagMapping, error) {
+	var result *models.CloudCostManagementTagMapping
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "PUT",
+		Organization: &org,
+		Route:        []string{"organizations", org, "cloud_cost_management", "tag_mapping"},
+		Body:         body,
+	}, &result)
+	return result, err
+}
+
+// cloudCostTagMappingCYModelToData converts the 'tm' into the 'data', empty
+// tags and regexes stay null when they are not set on 'data'.
+func cloudCostTagMappingCYModelToData(ctx context.Context, org string, tm *models.CloudCostManagementTagMapping, data *cloudCostTagMappingResourceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	tagsValue := func(current types.List, tags []string) types.List {
+		if len(tags) == 0 && current.IsNull() {
+			return current
+		}
+		l, d := types.ListValueFrom(ctx, types.StringType, tags)
+		diags.Append(d...)
+		return l
+	}
+	regexValue := func(current types.String, regex string) types.String {
+		if regex == "" && current.IsNull() {
+			return current
+		}
+		return types.StringValue(regex)
+	}
+
+	data.Organization = types.StringValue(org)
+	data.ProjectTags = tagsValue(data.ProjectTags, tm.ProjectTags)
+	data.ProjectRegex = regexValue(data.ProjectRegex, tm.ProjectRegex)
+	data.EnvironmentTags = tagsValue(data.EnvironmentTags, tm.EnvironmentTags)
+	data.EnvironmentRegex = regexValue(data.EnvironmentRegex, tm.EnvironmentRegex)
+	data.ComponentTags = tagsValue(data.ComponentTags, tm.ComponentTags)
+	data.ComponentRegex = regexValue(data.ComponentRegex, tm.ComponentRegex)
+	data.ID = ptrUint32ToInt64(tm.ID)
+
+	allTags := tm.AllTags
+	if allTags == nil {
+		allTags = []string{}
+	}
+	data.AllTags, d = types.ListValueFrom(ctx, types.StringType, allTags)
+	diags.Append(d...)
+
+	return diags
+}
@@ -15,11 +15,13 @@ import (
 	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
 	"github.com/cycloidio/cycloid-cli/gen/models"
 	"github.com/cycloidio/terraform-provider-cycloid/internal/dynamic"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/versionpolicy"
 	"github.com/cycloidio/terraform-provider-cycloid/resource_component"
 	"github.com/cycloidio/cycloid-cli/utils/ptr"
 )
 
 var _ resource.Resource = &ComponentResource{}
+var _ resource.ResourceWithModifyPlan = &ComponentResource{}
 
 type componentResourceModel resource_component.ComponentModel
 
@@ -56,6 +58,148 @@ func (r *ComponentResource) Configure(ctx context.Context, req resource.Configur
 	r.provider = pv
 }
 
+// ModifyPlan checks stack_version against catalog_version_policy, the policy
+// of the catalog repository of the stack, and against version_policy, so
+// components only consume the stack versions allowed by both.
+func (r *ComponentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.provider == nil || r.provider.Client == nil {
+		return
+	}
+
+	var plan, config componentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.StackRef.IsUnknown() || config.StackVersion.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state componentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.StackRef.Equal(state.StackRef) && plan.StackVersion.Equal(state.StackVersion) &&
+			plan.CatalogVersionPolicy.Equal(state.CatalogVersionPolicy) && plan.VersionPolicy.Equal(state.VersionPolicy) {
+			return
+		}
+	}
+
+	policies, diags := componentVersionPolicies(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(policies) == 0 {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, plan.Organization)
+	stackRef := plan.StackRef.ValueString()
+
+	if config.StackVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("stack_version"),
+			"Missing stack version",
+			"stack_version must be set when a version policy applies to the stack, the default version of the catalog repository is not checked against the policy.",
+		)
+		return
+	}
+
+	stackVersion := config.StackVersion.ValueString()
+
+	// The stack may not be indexed yet when its catalog repository is created
+	// in the same apply, the version is then only checked on the next plan.
+	versions, _, err := r.provider.Client.ListStackVersions(org, stackRef)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("stack_version"),
+			"Unable to check stack version against the version policy",
+			fmt.Sprintf("failed to list versions of stack %q in org %q: %s", stackRef, org, err.Error()),
+		)
+		return
+	}
+
+	var versionType string
+	switch tag, branch, commit := matchStackVersion(versions, &stackVersion); {
+	case tag != "":
+		versionType = versionpolicy.TypeTag
+	case branch != "":
+		versionType = versionpolicy.TypeBranch
+	case commit != "":
+		versionType = versionpolicy.TypeCommit
+	default:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("stack_version"),
+			"Unable to check stack version against the version policy",
+			fmt.Sprintf("stack %q has no tag, branch or commit matching %q yet.", stackRef, stackVersion),
+		)
+		return
+	}
+
+	for _, p := range policies {
+		if err := p.policy.Check(versionType, stackVersion); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("stack_version"),
+				"Stack version not allowed by the version policy",
+				fmt.Sprintf("%s: %s", p.attribute, err.Error()),
+			)
+		}
+	}
+}
+
+// componentVersionPolicy is a policy the stack version of a component is
+// checked against, with the attribute declaring it.
+type componentVersionPolicy struct {
+	attribute string
+	policy    *versionpolicy.Policy
+}
+
+// componentVersionPolicies returns the catalog_version_policy and the
+// version_policy of the component that are set, the stack version must be
+// accepted by all of them. The API does not store the policy of a catalog
+// repository, it is only known to the plan through catalog_version_policy.
+// A policy still unknown is reported as a warning and the version is checked
+// on the next plan.
+func componentVersionPolicies(ctx context.Context, plan componentResourceModel) ([]componentVersionPolicy, diag.Diagnostics) {
+	var (
+		diags    diag.Diagnostics
+		policies []componentVersionPolicy
+	)
+
+	for _, attr := range []struct {
+		name  string
+		value types.Object
+	}{
+		{"catalog_version_policy", plan.CatalogVersionPolicy},
+		{"version_policy", plan.VersionPolicy},
+	} {
+		if attr.value.IsNull() {
+			continue
+		}
+		if v, err := attr.value.ToTerraformValue(ctx); err != nil || !v.IsFullyKnown() {
+			diags.AddAttributeWarning(
+				path.Root(attr.name),
+				"Unable to check stack version against the version policy",
+				fmt.Sprintf("%s is not known yet, stack_version is checked against it on the next plan.", attr.name),
+			)
+			continue
+		}
+
+		policy, err := versionpolicy.FromObject(ctx, attr.value)
+		if err != nil {
+			diags.AddAttributeError(path.Root(attr.name), "Invalid version policy", err.Error())
+			continue
+		}
+		policies = append(policies, componentVersionPolicy{attribute: attr.name, policy: policy})
+	}
+
+	return policies, diags
+}
+
 func (r *ComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var componentState componentResourceModel
 
@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
 	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
 	"github.com/cycloidio/terraform-provider-cycloid/resource_config_repository"
 )
 
 var _ resource.Resource = (*configRepositoryResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*configRepositoryResource)(nil)
 
 func NewConfigRepositoryResource() resource.Resource {
 	return &configRepositoryResource{}
@@ -30,6 +36,13 @@ func (r *configRepositoryResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *configRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_config_repository.ConfigRepositoryResourceSchema(ctx)
+	resp.Schema.Attributes["validate_connection"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		Description:         "When true, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists. The check runs on create and whenever url, branch or credential_canonical change.",
+		MarkdownDescription: "When `true`, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists. The check runs on create and whenever `url`, `branch` or `credential_canonical` change.",
+	}
 }
 
 func (r *configRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -49,6 +62,47 @@ func (r *configRepositoryResource) Configure(ctx context.Context, req resource.C
 	r.provider = pv
 }
 
+func (r *configRepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.provider == nil || r.provider.Client == nil {
+		return
+	}
+
+	var plan configRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ValidateConnection.ValueBool() {
+		return
+	}
+
+	conn := repositoryConnection{
+		URL:        plan.Url,
+		Branch:     plan.Branch,
+		Credential: plan.CredentialCanonical,
+	}
+	if !conn.known() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state configRepositoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !conn.changed(repositoryConnection{
+			URL:        state.Url,
+			Branch:     state.Branch,
+			Credential: state.CredentialCanonical,
+		}) {
+			return
+		}
+	}
+
+	orgCan := getOrganizationCanonical(*r.provider, plan.OrganizationCanonical)
+	resp.Diagnostics.Append(validateRepositoryConnection(r.provider.Client, orgCan, conn)...)
+}
+
 func (r *configRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data configRepositoryResourceModel
 
@@ -80,6 +134,7 @@ func (r *configRepositoryResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	configRepositoryCYModelToData(orgCan, cr, &data)
+	resp.Diagnostics.Append(r.readSyncStatus(ctx, orgCan, &data)...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -102,7 +157,7 @@ func (r *configRepositoryResource) Read(ctx context.Context, req resource.ReadRe
 
 	orgCan := getOrganizationCanonical(*r.provider, data.OrganizationCanonical)
 
-	cr, _, err := mid.GetConfigRepository(orgCan, can)
+	cr, err := getConfigRepositoryWithSyncStatus(mid, orgCan, can)
 	if err != nil {
 		if isNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
@@ -115,7 +170,13 @@ func (r *configRepositoryResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	configRepositoryCYModelToData(orgCan, cr, &data)
+	configRepositoryCYModelToData(orgCan, &cr.ConfigRepository, &data)
+	resp.Diagnostics.Append(configRepositorySyncStatusToData(ctx, cr.SyncStatus, &data)...)
+
+	// State written by older provider versions has no validate_connection
+	if data.ValidateConnection.IsNull() {
+		data.ValidateConnection = types.BoolValue(false)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -161,6 +222,8 @@ func (r *configRepositoryResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// sync_status keeps its planned value from the state, it is read again
+	// on the next refresh
 	configRepositoryCYModelToData(orgCan, cr, &data)
 
 	// Save updated data into Terraform state
@@ -206,3 +269,70 @@ func configRepositoryCYModelToData(org string, cr *models.ConfigRepository, data
 
 	return diags
 }
+
+// configRepositoryWithSyncStatus is a config repository as returned by the
+// API, including the sync status the client model does not decode.
+type configRepositoryWithSyncStatus struct {
+	models.ConfigRepository
+
+	SyncStatus *models.ConfigRepositorySyncStatus `json:"config_repository_sync_status,omitempty"`
+}
+
+func getConfigRepositoryWithSyncStatus(m apiclient.APIClient, org, can string) (*configRepositoryWithSyncStatus, error) {
+	var result *configRepositoryWithSyncStatus
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org, "config_repositories", can},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, fmt.Errorf("empty response reading config repository %q", can)
+	}
+
+	return result, nil
+}
+
+// readSyncStatus fetches the sync status after a create. A failure
+// only warns since the repository itself was saved.
+func (r *configRepositoryResource) readSyncStatus(ctx context.Context, org string, data *configRepositoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var status *models.ConfigRepositorySyncStatus
+	cr, err := getConfigRepositoryWithSyncStatus(r.provider.Client, org, data.Canonical.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"Unable to read config repository sync status",
+			"The config repository was saved successfully, but its sync status could not be read and will be refreshed on the next plan. Error: "+err.Error(),
+		)
+	} else {
+		status = cr.SyncStatus
+	}
+
+	diags.Append(configRepositorySyncStatusToData(ctx, status, data)...)
+	return diags
+}
+
+// configRepositorySyncStatusToData sets sync_status, the API omits it when
+// nothing is waiting to be pushed.
+func configRepositorySyncStatusToData(ctx context.Context, status *models.ConfigRepositorySyncStatus, data *configRepositoryResourceModel) diag.Diagnostics {
+	if status == nil {
+		status = &models.ConfigRepositorySyncStatus{}
+	}
+
+	state := ptr.Value(status.State)
+	if state == "" {
+		state = "synced"
+	}
+
+	var diags diag.Diagnostics
+	data.SyncStatus, diags = types.ObjectValue(resource_config_repository.SyncStatusAttrTypes(), map[string]attr.Value{
+		"last_error":        types.StringValue(status.LastError),
+		"oldest_pending_at": ptrUint64ToInt64(status.OldestPendingAt),
+		"pending_count":     types.Int64Value(int64(ptr.Value(status.PendingCount))),
+		"state":             types.StringValue(state),
+	})
+	return diags
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/terraform-provider-cycloid/ephemeral_credential"
+)
+
+var _ ephemeral.EphemeralResource = (*credentialEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*credentialEphemeralResource)(nil)
+
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &credentialEphemeralResource{}
+}
+
+type credentialEphemeralResource struct {
+	provider *CycloidProvider
+}
+
+type credentialEphemeralResourceModel = ephemeral_credential.CredentialModel
+
+func (r *credentialEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+func (r *credentialEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ephemeral_credential.CredentialEphemeralResourceSchema(ctx)
+}
+
+func (r *credentialEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+func (r *credentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data credentialEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := getOrganizationCanonical(*r.provider, data.Organization)
+	canonical := data.Canonical.ValueString()
+
+	credential, _, err := r.provider.Client.GetCredential(organization, canonical)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get credential with canonical '"+canonical+"'", err.Error())
+		return
+	}
+	if credential == nil {
+		resp.Diagnostics.AddError("failed to get credential with canonical '"+canonical+"'", "empty response from the API")
+		return
+	}
+
+	resp.Diagnostics.Append(credentialEphemeralModelToData(ctx, organization, credential, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func credentialEphemeralModelToData(ctx context.Context, org string, credential *models.Credential, data *credentialEphemeralResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Organization = types.StringValue(org)
+	data.Canonical = types.StringPointerValue(credential.Canonical)
+	data.Name = types.StringPointerValue(credential.Name)
+	data.Type = types.StringPointerValue(credential.Type)
+	data.Path = types.StringPointerValue(credential.Path)
+	data.Description = types.StringValue(credential.Description)
+	if credential.Owner != nil && credential.Owner.Username != nil {
+		data.Owner = types.StringPointerValue(credential.Owner.Username)
+	} else {
+		data.Owner = types.StringValue("")
+	}
+
+	var d diag.Diagnostics
+	data.Keys, d = types.ListValueFrom(ctx, types.StringType, credential.Keys)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Body, d = credentialRawToEphemeralBody(ctx, data.Type.ValueString(), credential.Raw)
+	diags.Append(d...)
+	return diags
+}
+
+// credentialRawToEphemeralBody builds the body from the raw credential, the
+// fields not used by credentialType are null.
+func credentialRawToEphemeralBody(ctx context.Context, credentialType string, raw *models.CredentialRaw) (types.Object, diag.Diagnostics) {
+	if raw == nil {
+		raw = &models.CredentialRaw{}
+	}
+
+	rawValue, diags := types.MapValueFrom(ctx, types.StringType, raw.Raw)
+	if diags.HasError() {
+		return types.ObjectNull(ephemeral_credential.BodyAttrTypes()), diags
+	}
+
+	values := map[string]attr.Value{
+		"access_key":      types.StringValue(raw.AccessKey),
+		"account_name":    types.StringValue(raw.AccountName),
+		"auth_url":        types.StringValue(raw.AuthURL),
+		"ca_cert":         types.StringValue(raw.CaCert),
+		"client_id":       types.StringValue(raw.ClientID),
+		"client_secret":   types.StringValue(raw.ClientSecret),
+		"domain_id":       types.StringValue(raw.DomainID),
+		"environment":     types.StringValue(raw.Environment),
+		"json_key":        types.StringValue(raw.JSONKey),
+		"password":        types.StringValue(raw.Password),
+		"secret_key":      types.StringValue(raw.SecretKey),
+		"ssh_key":         types.StringValue(raw.SSHKey),
+		"subscription_id": types.StringValue(raw.SubscriptionID),
+		"tenant_id":       types.StringValue(raw.TenantID),
+		"username":        types.StringValue(raw.Username),
+		"raw":             rawValue,
+	}
+
+	// Unknown types keep every field, the API may have added a type this
+	// provider does not know about yet.
+	if fields, ok := credentialTypeAllowedBodyFields(credentialType); ok {
+		for k, t := range ephemeral_credential.BodyAttrTypes() {
+			if slices.Contains(fields, k) {
+				continue
+			}
+			if mt, ok := t.(types.MapType); ok {
+				values[k] = types.MapNull(mt.ElemType)
+			} else {
+				values[k] = types.StringNull()
+			}
+		}
+	}
+
+	return types.ObjectValue(ephemeral_credential.BodyAttrTypes(), values)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"slices"
@@ -20,6 +21,7 @@ import (
 )
 
 var _ resource.Resource = (*credentialResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*credentialResource)(nil)
 
 func NewCredentialResource() resource.Resource {
 	return &credentialResource{}
@@ -56,6 +58,16 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 	r.provider = pv
 }
 
+func (r *credentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data credentialResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCredentialConfig(data)...)
+}
+
 func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data credentialResourceModel
 	var configData credentialResourceModel
@@ -89,13 +101,19 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	writeOnlyFields, diags := applyCredentialWriteOnly(ctx, configData, rawCred)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	path := data.Path.ValueString()
 	canonical := data.Canonical.ValueString()
 	description := data.Description.ValueString()
 	organization := getOrganizationCanonical(*r.provider, data.OrganizationCanonical)
 	owner, _ := configuredCredentialOwner(configData.Owner)
 
-	cred, _, err := r.createCredential(organization, name, credentialType, rawCred, path, canonical, description, owner)
+	cred, _, err := createCredential(r.provider.Client, organization, name, credentialType, rawCred, path, canonical, description, owner)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create credential",
@@ -110,6 +128,9 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	blankCredentialWriteOnlyFields(ctx, writeOnlyFields, &data)
+	resp.Diagnostics.Append(setCredentialWriteOnlyFields(ctx, resp.Private, writeOnlyFields)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -161,6 +182,14 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Secrets set through write-only attributes must not end up in the state
+	writeOnlyFields, diags := getCredentialWriteOnlyFields(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blankCredentialWriteOnlyFields(ctx, writeOnlyFields, &data)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -206,6 +235,12 @@ func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	writeOnlyFields, diags := applyCredentialWriteOnly(ctx, configData, rawCred)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	path := data.Path.ValueString()
 	updateCanonical := credentialCanonicalForUpdate(data.Canonical.ValueString(), stateData.Canonical.ValueString())
 	createCanonical := credentialCanonicalForCreate(data.Canonical.ValueString(), stateData.Canonical.ValueString())
@@ -226,9 +261,9 @@ func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	if slices.IndexFunc(credentials, func(c *models.CredentialSimple) bool {
 		return c.Canonical != nil && *c.Canonical == updateCanonical
 	}) == -1 {
-		credential, _, err = r.createCredential(organization, name, credentialType, rawCred, path, createCanonical, description, owner)
+		credential, _, err = createCredential(m, organization, name, credentialType, rawCred, path, createCanonical, description, owner)
 	} else {
-		credential, _, err = r.updateCredential(organization, name, credentialType, rawCred, path, updateCanonical, description, owner)
+		credential, _, err = updateCredential(m, organization, name, credentialType, rawCred, path, updateCanonical, description, owner)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update credential", err.Error())
@@ -241,6 +276,9 @@ func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	blankCredentialWriteOnlyFields(ctx, writeOnlyFields, &data)
+	resp.Diagnostics.Append(setCredentialWriteOnlyFields(ctx, resp.Private, writeOnlyFields)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -278,11 +316,37 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		time.Sleep(delay)
 	}
 
+	if err != nil && isConflictError(err) {
+		resp.Diagnostics.Append(credentialInUseDiagnostic(m, organization, canonical, err)...)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to delete credential", err.Error())
 	}
 }
 
+// credentialInUseDiagnostic explains a delete rejected because the credential
+// is still in use, naming the objects using it.
+func credentialInUseDiagnostic(m cycloidapiclient.APIClient, org, canonical string, deleteErr error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	usage, err := getCredentialUsage(m, org, canonical)
+	if err != nil || !usage.inUse() {
+		diags.AddError("Unable to delete credential", deleteErr.Error())
+		return diags
+	}
+
+	diags.AddError(
+		"Unable to delete credential, it is still in use",
+		fmt.Sprintf(
+			"Credential %q cannot be deleted while these objects use it:\n  - %s\n\nPoint them to another credential or delete them first, the cycloid_credential_usage data source lists them. API error: %s",
+			canonical, strings.Join(usage.dependents(), "\n  - "), deleteErr.Error(),
+		),
+	)
+	return diags
+}
+
 // credentialCYModelToData converts the 'cred' into the 'credentialResourceModel'
 func credentialCYModelToData(ctx context.Context, org string, credential *models.Credential, data *credentialResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -430,6 +494,125 @@ func dataRawToCredentialRawCYModel(ctx context.Context, data credentialResourceM
 	return rawCred, nil
 }
 
+// credentialWriteOnlyPrivateKey is the private state key listing the body
+// fields set through their write-only counterpart.
+const credentialWriteOnlyPrivateKey = "write_only_fields"
+
+// credentialWriteOnlyBodyField pairs a body field with its write-only
+// counterpart.
+type credentialWriteOnlyBodyField struct {
+	name      string
+	body      attr.Value
+	writeOnly attr.Value
+}
+
+func credentialWriteOnlyBodyFields(data credentialResourceModel) []credentialWriteOnlyBodyField {
+	return []credentialWriteOnlyBodyField{
+		{name: "client_secret", body: data.Body.ClientSecret, writeOnly: data.ClientSecretWo},
+		{name: "json_key", body: data.Body.JsonKey, writeOnly: data.JsonKeyWo},
+		{name: "password", body: data.Body.Password, writeOnly: data.PasswordWo},
+		{name: "raw", body: data.Body.Raw, writeOnly: data.RawWo},
+		{name: "secret_key", body: data.Body.SecretKey, writeOnly: data.SecretKeyWo},
+		{name: "ssh_key", body: data.Body.SshKey, writeOnly: data.SshKeyWo},
+	}
+}
+
+// applyCredentialWriteOnly sets on rawCred the secrets given through the
+// write-only attributes of config, and returns the body fields they replace.
+func applyCredentialWriteOnly(ctx context.Context, config credentialResourceModel, rawCred *models.CredentialRaw) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var fields []string
+	for _, f := range credentialWriteOnlyBodyFields(config) {
+		if !f.writeOnly.IsNull() && !f.writeOnly.IsUnknown() {
+			fields = append(fields, f.name)
+		}
+	}
+
+	for _, f := range fields {
+		switch f {
+		case "client_secret":
+			rawCred.ClientSecret = config.ClientSecretWo.ValueString()
+		case "json_key":
+			rawCred.JSONKey = config.JsonKeyWo.ValueString()
+		case "password":
+			rawCred.Password = config.PasswordWo.ValueString()
+		case "secret_key":
+			rawCred.SecretKey = config.SecretKeyWo.ValueString()
+		case "ssh_key":
+			rawCred.SSHKey = config.SshKeyWo.ValueString()
+		case "raw":
+			raw := make(map[string]string, len(config.RawWo.Elements()))
+			diags.Append(config.RawWo.ElementsAs(ctx, &raw, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			rawCred.Raw = raw
+		}
+	}
+
+	return fields, diags
+}
+
+// blankCredentialWriteOnlyFields clears the body fields set through a
+// write-only attribute so the secrets are not stored in the state.
+func blankCredentialWriteOnlyFields(ctx context.Context, fields []string, data *credentialResourceModel) {
+	for _, f := range fields {
+		switch f {
+		case "client_secret":
+			data.Body.ClientSecret = types.StringValue("")
+		case "json_key":
+			data.Body.JsonKey = types.StringValue("")
+		case "password":
+			data.Body.Password = types.StringValue("")
+		case "secret_key":
+			data.Body.SecretKey = types.StringValue("")
+		case "ssh_key":
+			data.Body.SshKey = types.StringValue("")
+		case "raw":
+			data.Body.Raw = types.MapNull(types.StringType)
+		}
+	}
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func setCredentialWriteOnlyFields(ctx context.Context, private privateStateSetter, fields []string) diag.Diagnostics {
+	if fields == nil {
+		fields = []string{}
+	}
+
+	value, err := json.Marshal(fields)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("failed to encode credential write-only fields", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, credentialWriteOnlyPrivateKey, value)
+}
+
+func getCredentialWriteOnlyFields(ctx context.Context, private privateStateGetter) ([]string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, credentialWriteOnlyPrivateKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var fields []string
+	if err := json.Unmarshal(value, &fields); err != nil {
+		diags.AddError("failed to decode credential write-only fields", err.Error())
+		return nil, diags
+	}
+
+	return fields, diags
+}
+
 func credentialCanonicalForUpdate(planCanonical, stateCanonical string) string {
 	return Coalesce(stateCanonical, planCanonical)
 }
@@ -460,7 +643,7 @@ func resolveCredentialUpdateOwner(configOwner, stateOwner types.String) string {
 	return owner
 }
 
-func (r *credentialResource) createCredential(org, name, credentialType string, rawCred *models.CredentialRaw, path, canonical, description, owner string) (*models.Credential, *http.Response, error) {
+func createCredential(m cycloidapiclient.APIClient, org, name, credentialType string, rawCred *models.CredentialRaw, path, canonical, description, owner string) (*models.Credential, *http.Response, error) {
 	body := &models.NewCredential{
 		Description: description,
 		Name:        &name,
@@ -474,7 +657,7 @@ func (r *credentialResource) createCredential(org, name, credentialType string,
 	}
 
 	var result *models.Credential
-	resp, err := r.provider.Client.GenericRequest(cycloidapiclient.Request{
+	resp, err := m.GenericRequest(cycloidapiclient.Request{
 		Method:       "POST",
 		Organization: &org,
 		Route:        []string{"organizations", org, "credentials"},
@@ -486,7 +669,7 @@ func (r *credentialResource) createCredential(org, name, credentialType string,
 	return result, resp, nil
 }
 
-func (r *credentialResource) updateCredential(org, name, credentialType string, rawCred *models.CredentialRaw, path, canonical, description, owner string) (*models.Credential, *http.Response, error) {
+func updateCredential(m cycloidapiclient.APIClient, org, name, credentialType string, rawCred *models.CredentialRaw, path, canonical, description, owner string) (*models.Credential, *http.Response, error) {
 	body := &models.UpdateCredential{
 		Description: description,
 		Name:        &name,
@@ -500,7 +683,7 @@ func (r *credentialResource) updateCredential(org, name, credentialType string,
 	}
 
 	var result *models.Credential
-	resp, err := r.provider.Client.GenericRequest(cycloidapiclient.Request{
+	resp, err := m.GenericRequest(cycloidapiclient.Request{
 		Method:       "PUT",
 		Organization: &org,
 		Route:        []string{"organizations", org, "credentials", canonical},
@@ -0,0 +1,570 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_credential_rotation"
+)
+
+var _ resource.Resource = (*credentialRotationResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*credentialRotationResource)(nil)
+
+const (
+	credentialRotationDefaultTimeout = 30 * time.Minute
+	credentialRotationPollInterval   = 15 * time.Second
+)
+
+// credentialVersionSuffix matches the suffix appended to the path and name
+// of a rotated credential.
+var credentialVersionSuffix = regexp.MustCompile(`[- ]v[0-9]+$`)
+
+func NewCredentialRotationResource() resource.Resource {
+	return &credentialRotationResource{}
+}
+
+type credentialRotationResource struct {
+	provider *CycloidProvider
+}
+
+type credentialRotationResourceModel = resource_credential_rotation.CredentialRotationModel
+
+func (r *credentialRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_rotation"
+}
+
+func (r *credentialRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_credential_rotation.CredentialRotationResourceSchema(ctx)
+}
+
+func (r *credentialRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+// ModifyPlan marks the current credential as changing when the trigger
+// changes, the computed attributes otherwise keep their state value.
+// dependents has no UseStateForUnknown, it is carried over here.
+func (r *credentialRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state credentialRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationTrigger.Equal(state.RotationTrigger) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dependents"), state.Dependents)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("credential_canonical"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("path"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dependents"), types.ListUnknown(types.StringType))...)
+}
+
+func (r *credentialRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config credentialRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	data.Organization = types.StringValue(org)
+
+	// The source credential is the version 0, the first rotation happens
+	// when rotation_trigger changes. body is only checked here, it is sent
+	// by the rotations.
+	_, diags := credentialRotationConfigToRaw(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := r.provider.Client
+	source := data.SourceCredentialCanonical.ValueString()
+	credential, _, err := m.GetCredential(org, source)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read credential '"+source+"'", err.Error())
+		return
+	}
+
+	usage, err := getCredentialUsage(m, org, source)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read usage of credential '"+source+"'", err.Error())
+		return
+	}
+
+	data.Version = types.Int64Value(0)
+	data.CredentialCanonical = types.StringValue(source)
+	data.Path = types.StringPointerValue(credential.Path)
+	data.Dependents, diags = types.ListValueFrom(ctx, types.StringType, usage.dependents())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *credentialRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data credentialRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	canonical := data.CredentialCanonical.ValueString()
+
+	credential, _, err := r.provider.Client.GetCredential(org, canonical)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read credential '"+canonical+"'", err.Error())
+		return
+	}
+
+	data.Path = types.StringPointerValue(credential.Path)
+
+	usage, err := getCredentialUsage(r.provider.Client, org, canonical)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read usage of credential '"+canonical+"'", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Dependents, diags = types.ListValueFrom(ctx, types.StringType, usage.dependents())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *credentialRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state, config credentialRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	data.Organization = types.StringValue(org)
+
+	rawCred, diags := credentialRotationConfigToRaw(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RotationTrigger.Equal(state.RotationTrigger) {
+		resp.Diagnostics.Append(r.rotate(ctx, org, state.CredentialCanonical.ValueString(), state.Version.ValueInt64()+1, rawCred, &data)...)
+		if resp.Diagnostics.HasError() {
+			// Keep the previous trigger so the next apply resumes the rotation
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Only the body, its version or the pipeline to wait for changed, the
+	// current credential is updated in place. Before the first rotation the
+	// current credential is the source one, which is left to its
+	// cycloid_credential resource, the body is then sent by the rotation.
+	m := r.provider.Client
+	canonical := state.CredentialCanonical.ValueString()
+	if canonical == state.SourceCredentialCanonical.ValueString() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	credential, _, err := m.GetCredential(org, canonical)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read credential '"+canonical+"'", err.Error())
+		return
+	}
+
+	_, _, err = updateCredential(m, org, ptr.Value(credential.Name), ptr.Value(credential.Type), rawCred, ptr.Value(credential.Path), canonical, credential.Description, credentialOwnerUsername(credential))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update credential '"+canonical+"'", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the versions created by the rotations, the source
+// credential is kept. The versions still in use, usually the current one, are
+// kept too and reported as a warning, the API rejects their deletion.
+func (r *credentialRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data credentialRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := r.provider.Client
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	// A failed rotation may have left the previous versions behind
+	var kept []string
+	for version := int64(1); version <= data.Version.ValueInt64(); version++ {
+		canonical := fmt.Sprintf("%s-v%d", data.SourceCredentialCanonical.ValueString(), version)
+		_, err := m.DeleteCredential(org, canonical)
+		switch {
+		case err == nil, isNotFoundError(err):
+		case isConflictError(err):
+			kept = append(kept, canonical)
+		default:
+			resp.Diagnostics.AddError("failed to delete credential '"+canonical+"'", err.Error())
+		}
+	}
+
+	if len(kept) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Rotated credentials kept",
+			fmt.Sprintf("Credentials '%s' are still in use and were not deleted, the cycloid_credential_usage data source lists the objects using them. Point them to another credential and delete these credentials.", strings.Join(kept, "', '")),
+		)
+	}
+}
+
+// rotate replaces the credential from by the version of it holding rawCred.
+// The dependents of from are pointed to the new credential, then from is
+// deleted unless it is the source credential, which is usually managed by a
+// cycloid_credential resource. When the awaited pipeline build fails, the
+// dependents are pointed back to from. Every step can be run again, so a
+// failed rotation is resumed by calling rotate with the same arguments.
+func (r *credentialRotationResource) rotate(ctx context.Context, org, from string, version int64, rawCred *models.CredentialRaw, data *credentialRotationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m := r.provider.Client
+
+	old, _, err := m.GetCredential(org, from)
+	if err != nil {
+		diags.AddError("failed to read credential '"+from+"'", err.Error())
+		return diags
+	}
+
+	canonical := fmt.Sprintf("%s-v%d", data.SourceCredentialCanonical.ValueString(), version)
+	credPath := fmt.Sprintf("%s-v%d", credentialVersionSuffix.ReplaceAllString(ptr.Value(old.Path), ""), version)
+	name := fmt.Sprintf("%s v%d", credentialVersionSuffix.ReplaceAllString(ptr.Value(old.Name), ""), version)
+	credentialType := ptr.Value(old.Type)
+	owner := credentialOwnerUsername(old)
+
+	started := time.Now()
+	_, _, err = createCredential(m, org, name, credentialType, rawCred, credPath, canonical, old.Description, owner)
+	if isConflictError(err) {
+		// Created by a previous attempt of this rotation
+		_, _, err = updateCredential(m, org, name, credentialType, rawCred, credPath, canonical, old.Description, owner)
+	}
+	if err != nil {
+		diags.AddError("failed to create credential '"+canonical+"'", err.Error())
+		return diags
+	}
+	tflog.Info(ctx, fmt.Sprintf("created credential %q, pointing the dependents of %q to it", canonical, from))
+
+	usage, err := getCredentialUsage(m, org, from)
+	if err != nil {
+		diags.AddError("failed to read usage of credential '"+from+"'", err.Error())
+		return diags
+	}
+
+	diags.Append(repointCredentialDependents(m, org, from, canonical, usage)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.WaitForPipeline.IsNull() {
+		diags.Append(waitForCredentialRotationBuild(ctx, m, org, data.WaitForPipeline, started)...)
+		if diags.HasError() {
+			// Point the dependents back to the credential they used
+			rollback := repointCredentialDependents(m, org, canonical, from, usage)
+			if rollback.HasError() {
+				diags.Append(rollback...)
+				diags.AddError(
+					"Rotation not rolled back",
+					fmt.Sprintf("Some dependents of '%s' still use the new credential '%s', see the errors above. Apply again to resume the rotation.", from, canonical),
+				)
+				return diags
+			}
+			diags.AddError(
+				"Rotation rolled back",
+				fmt.Sprintf("The dependents were pointed back to the previous credential '%s', the new credential '%s' is kept. Fix the pipeline or the credential and apply again to resume the rotation.", from, canonical),
+			)
+			return diags
+		}
+	}
+
+	if from != data.SourceCredentialCanonical.ValueString() {
+		_, err = m.DeleteCredential(org, from)
+		if isConflictError(err) {
+			diags.Append(credentialInUseDiagnostic(m, org, from, err)...)
+			return diags
+		}
+		if err != nil && !isNotFoundError(err) {
+			diags.AddError("failed to delete credential '"+from+"'", err.Error())
+			return diags
+		}
+	}
+
+	newUsage, err := getCredentialUsage(m, org, canonical)
+	if err != nil {
+		diags.AddError("failed to read usage of credential '"+canonical+"'", err.Error())
+		return diags
+	}
+
+	var d diag.Diagnostics
+	data.Version = types.Int64Value(version)
+	data.CredentialCanonical = types.StringValue(canonical)
+	data.Path = types.StringValue(credPath)
+	data.Dependents, d = types.ListValueFrom(ctx, types.StringType, newUsage.dependents())
+	diags.Append(d...)
+	return diags
+}
+
+// repointCredentialDependents points the objects using the credential from
+// to the credential to.
+func repointCredentialDependents(m apiclient.APIClient, org, from, to string, usage *credentialUsage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, inUse := range usage.InUse.ConfigRepositories {
+		can := ptr.Value(inUse.Canonical)
+		cr, _, err := m.GetConfigRepository(org, can)
+		if err == nil {
+			_, _, err = m.UpdateConfigRepository(org, can, to, ptr.Value(cr.Name), ptr.Value(cr.URL), cr.Branch, ptr.Value(cr.Default))
+		}
+		if err != nil {
+			diags.AddError("failed to point config repository '"+can+"' to credential '"+to+"'", err.Error())
+		}
+	}
+
+	for _, inUse := range usage.InUse.ServiceCatalogSources {
+		can := ptr.Value(inUse.Canonical)
+		scs, _, err := m.GetCatalogRepository(org, can)
+		if err == nil {
+			_, _, err = m.UpdateCatalogRepository(org, can, ptr.Value(scs.Name), ptr.Value(scs.URL), scs.Branch, to, nil)
+		}
+		if err != nil {
+			diags.AddError("failed to point catalog repository '"+can+"' to credential '"+to+"'", err.Error())
+		}
+	}
+
+	for _, ca := range usage.CloudAccounts {
+		can := ptr.Value(ca.Canonical)
+		owner := ""
+		if ca.Owner != nil {
+			owner = ptr.Value(ca.Owner.Username)
+		}
+		// The PUT replaces the cloud account, its configuration is sent back
+		configuration, err := getCloudProviderConfiguration(m, org, can)
+		if err == nil {
+			err = updateCloudAccount(m, org, can, updateCloudAccountRequest{
+				UpdateCloudAccount: &models.UpdateCloudAccount{
+					Name:                ca.Name,
+					CredentialCanonical: &to,
+					Description:         ca.Description,
+					Owner:               owner,
+				},
+				Configuration: configuration,
+			})
+		}
+		if err != nil {
+			diags.AddError("failed to point cloud account '"+can+"' to credential '"+to+"'", err.Error())
+		}
+	}
+
+	// The in use API does not return the ID of the external backends
+	if len(usage.InUse.ExternalBackends) > 0 {
+		ebs, _, err := m.ListExternalBackends(org)
+		if err != nil {
+			diags.AddError("failed to list external backends", err.Error())
+			return diags
+		}
+		for _, eb := range ebs {
+			if eb.CredentialCanonical != from {
+				continue
+			}
+			_, _, err := m.UpdateExternalBackend(org, eb.ID, ptr.Value(eb.Purpose), to, ptr.Value(eb.Default), eb.Configuration())
+			if err != nil {
+				diags.AddError(fmt.Sprintf("failed to point external backend %d to credential '%s'", eb.ID, to), err.Error())
+			}
+		}
+	}
+
+	return diags
+}
+
+// waitForCredentialRotationBuild waits for a build of the job started after
+// the rotation to succeed.
+func waitForCredentialRotationBuild(ctx context.Context, m apiclient.APIClient, org string, obj types.Object, started time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var wait resource_credential_rotation.WaitForPipelineModel
+	diags.Append(obj.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	timeout := credentialRotationDefaultTimeout
+	if t := wait.Timeout.ValueString(); t != "" {
+		var err error
+		if timeout, err = time.ParseDuration(t); err != nil {
+			diags.AddAttributeError(path.Root("wait_for_pipeline").AtName("timeout"), "Invalid timeout", err.Error())
+			return diags
+		}
+	}
+
+	project, env, component := wait.Project.ValueString(), wait.Environment.ValueString(), wait.Component.ValueString()
+	pipeline, job := wait.Pipeline.ValueString(), wait.Job.ValueString()
+	jobRef := fmt.Sprintf("%s/%s/%s/%s/%s", project, env, component, pipeline, job)
+
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(credentialRotationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		builds, _, err := m.GetBuilds(org, project, env, component, pipeline, job)
+		if err != nil {
+			tflog.Warn(ctx, "transient error listing builds; will retry", map[string]any{
+				"job":   jobRef,
+				"error": err.Error(),
+			})
+		}
+		for _, b := range builds {
+			if b == nil || int64(b.StartTime) < started.Unix() {
+				continue
+			}
+			switch ptr.Value(b.Status) {
+			case "succeeded":
+				return diags
+			case "failed", "errored", "aborted":
+				diags.AddError(
+					"Pipeline build failed with the new credential",
+					fmt.Sprintf("Build %s of job %s ended with status %q.", ptr.Value(b.Name), jobRef, ptr.Value(b.Status)),
+				)
+				return diags
+			}
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				"Timeout waiting for a pipeline build",
+				fmt.Sprintf("No build of job %s succeeded within %s.", jobRef, timeout),
+			)
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Context cancelled while waiting for a pipeline build", ctx.Err().Error())
+			return diags
+		case <-ticker.C:
+		}
+	}
+}
+
+// credentialRotationConfigToRaw builds the values of the credential from the
+// body and the write-only attributes of config, the latter taking
+// precedence.
+func credentialRotationConfigToRaw(ctx context.Context, config credentialRotationResourceModel) (*models.CredentialRaw, diag.Diagnostics) {
+	rawCred, diags := credentialRotationBodyToRaw(ctx, config.Body)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The write-only attributes are the ones of cycloid_credential
+	_, d := applyCredentialWriteOnly(ctx, credentialResourceModel{
+		ClientSecretWo: config.ClientSecretWo,
+		JsonKeyWo:      config.JsonKeyWo,
+		PasswordWo:     config.PasswordWo,
+		RawWo:          config.RawWo,
+		SecretKeyWo:    config.SecretKeyWo,
+		SshKeyWo:       config.SshKeyWo,
+	}, rawCred)
+	diags.Append(d...)
+	return rawCred, diags
+}
+
+// credentialRotationBodyToRaw builds the values of the credential from body,
+// a null body gives empty values.
+func credentialRotationBodyToRaw(ctx context.Context, obj types.Object) (*models.CredentialRaw, diag.Diagnostics) {
+	var body resource_credential_rotation.BodyModel
+	diags := obj.As(ctx, &body, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	rawCred := &models.CredentialRaw{
+		AccessKey:      body.AccessKey.ValueString(),
+		AccountName:    body.AccountName.ValueString(),
+		AuthURL:        body.AuthUrl.ValueString(),
+		CaCert:         body.CaCert.ValueString(),
+		ClientID:       body.ClientId.ValueString(),
+		ClientSecret:   body.ClientSecret.ValueString(),
+		DomainID:       body.DomainId.ValueString(),
+		Environment:    body.Environment.ValueString(),
+		JSONKey:        body.JsonKey.ValueString(),
+		Password:       body.Password.ValueString(),
+		SecretKey:      body.SecretKey.ValueString(),
+		SSHKey:         body.SshKey.ValueString(),
+		SubscriptionID: body.SubscriptionId.ValueString(),
+		TenantID:       body.TenantId.ValueString(),
+		Username:       body.Username.ValueString(),
+	}
+
+	if !body.Raw.IsNull() && !body.Raw.IsUnknown() {
+		diags.Append(body.Raw.ElementsAs(ctx, &rawCred.Raw, false)...)
+	}
+
+	return rawCred, diags
+}
+
+func credentialOwnerUsername(credential *models.Credential) string {
+	if credential.Owner == nil {
+		return ""
+	}
+	return ptr.Value(credential.Owner.Username)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+)
+
+// credentialUsage lists the objects using a credential. The API reports the
+// repositories and external backends, cloud accounts are found by listing
+// them.
+type credentialUsage struct {
+	InUse         models.CredentialInUse
+	CloudAccounts []*models.CloudAccountDetail
+}
+
+func getCredentialUsage(m apiclient.APIClient, org, canonical string) (*credentialUsage, error) {
+	credential, _, err := m.GetCredential(org, canonical)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &credentialUsage{}
+	if credential != nil && credential.InUse != nil {
+		usage.InUse = *credential.InUse
+	}
+
+	cas, _, err := m.ListCloudAccounts(org)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cloud accounts: %w", err)
+	}
+	for _, ca := range cas {
+		if ca.Credential != nil && ptr.Value(ca.Credential.Canonical) == canonical {
+			usage.CloudAccounts = append(usage.CloudAccounts, ca)
+		}
+	}
+
+	return usage, nil
+}
+
+func (u *credentialUsage) inUse() bool {
+	return len(u.InUse.ConfigRepositories) > 0 ||
+		len(u.InUse.ServiceCatalogSources) > 0 ||
+		len(u.InUse.ExternalBackends) > 0 ||
+		len(u.CloudAccounts) > 0
+}
+
+// dependents describes each object using the credential, one per line.
+func (u *credentialUsage) dependents() []string {
+	var lines []string
+	for _, cr := range u.InUse.ConfigRepositories {
+		lines = append(lines, fmt.Sprintf("config repository %q (%s)", ptr.Value(cr.Canonical), ptr.Value(cr.Name)))
+	}
+	for _, scs := range u.InUse.ServiceCatalogSources {
+		lines = append(lines, fmt.Sprintf("catalog repository %q (%s)", ptr.Value(scs.Canonical), ptr.Value(scs.Name)))
+	}
+	for _, ca := range u.CloudAccounts {
+		lines = append(lines, fmt.Sprintf("cloud account %q (%s)", ptr.Value(ca.Canonical), ptr.Value(ca.Name)))
+	}
+	for _, eb := range u.InUse.ExternalBackends {
+		line := fmt.Sprintf("%s external backend %q", ptr.Value(eb.Purpose), ptr.Value(eb.Engine))
+		var scope []string
+		if eb.Project != nil {
+			scope = append(scope, "project "+ptr.Value(eb.Project.Canonical))
+		}
+		if eb.Environment != nil {
+			scope = append(scope, "environment "+ptr.Value(eb.Environment.Canonical))
+		}
+		if eb.Component != nil {
+			scope = append(scope, "component "+ptr.Value(eb.Component.Canonical))
+		}
+		if len(scope) > 0 {
+			line += " of " + strings.Join(scope, ", ")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_credential_usage"
+)
+
+var _ datasource.DataSource = &credentialUsageDataSource{}
+
+type credentialUsageDatasourceModel = datasource_credential_usage.CredentialUsageModel
+
+type credentialUsageDataSource struct {
+	provider *CycloidProvider
+}
+
+func NewCredentialUsageDataSource() datasource.DataSource {
+	return &credentialUsageDataSource{}
+}
+
+func (s *credentialUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_usage"
+}
+
+func (s *credentialUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_credential_usage.CredentialUsageDataSourceSchema(ctx)
+}
+
+func (s *credentialUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+	s.provider = pv
+}
+
+func (s *credentialUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data credentialUsageDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+	canonical := data.Canonical.ValueString()
+
+	usage, err := getCredentialUsage(s.provider.Client, org, canonical)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read usage of credential '"+canonical+"'", err.Error())
+		return
+	}
+
+	data.Organization = types.StringValue(org)
+	resp.Diagnostics.Append(credentialUsageToData(ctx, usage, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func credentialUsageToData(ctx context.Context, usage *credentialUsage, data *credentialUsageDatasourceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.InUse = types.BoolValue(usage.inUse())
+
+	configRepositories := make([]datasource_credential_usage.RepositoryItem, 0, len(usage.InUse.ConfigRepositories))
+	for _, cr := range usage.InUse.ConfigRepositories {
+		configRepositories = append(configRepositories, datasource_credential_usage.RepositoryItem{
+			Canonical: types.StringPointerValue(cr.Canonical),
+			Name:      types.StringPointerValue(cr.Name),
+		})
+	}
+	data.ConfigRepositories, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_credential_usage.RepositoryAttrTypes()}, configRepositories)
+	diags.Append(d...)
+
+	catalogRepositories := make([]datasource_credential_usage.RepositoryItem, 0, len(usage.InUse.ServiceCatalogSources))
+	for _, scs := range usage.InUse.ServiceCatalogSources {
+		catalogRepositories = append(catalogRepositories, datasource_credential_usage.RepositoryItem{
+			Canonical: types.StringPointerValue(scs.Canonical),
+			Name:      types.StringPointerValue(scs.Name),
+		})
+	}
+	data.CatalogRepositories, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_credential_usage.RepositoryAttrTypes()}, catalogRepositories)
+	diags.Append(d...)
+
+	cloudAccounts := make([]datasource_credential_usage.CloudAccountItem, 0, len(usage.CloudAccounts))
+	for _, ca := range usage.CloudAccounts {
+		cloudAccounts = append(cloudAccounts, datasource_credential_usage.CloudAccountItem{
+			Canonical:     types.StringPointerValue(ca.Canonical),
+			Name:          types.StringPointerValue(ca.Name),
+			CloudProvider: types.StringPointerValue(ca.CloudProvider),
+		})
+	}
+	data.CloudAccounts, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_credential_usage.CloudAccountAttrTypes()}, cloudAccounts)
+	diags.Append(d...)
+
+	externalBackends := make([]datasource_credential_usage.ExternalBackendItem, 0, len(usage.InUse.ExternalBackends))
+	for _, eb := range usage.InUse.ExternalBackends {
+		item := datasource_credential_usage.ExternalBackendItem{
+			Engine:      types.StringPointerValue(eb.Engine),
+			Purpose:     types.StringPointerValue(eb.Purpose),
+			Project:     types.StringNull(),
+			Environment: types.StringNull(),
+			Component:   types.StringNull(),
+		}
+		if eb.Project != nil {
+			item.Project = types.StringPointerValue(eb.Project.Canonical)
+		}
+		if eb.Environment != nil {
+			item.Environment = types.StringPointerValue(eb.Environment.Canonical)
+		}
+		if eb.Component != nil {
+			item.Component = types.StringPointerValue(eb.Component.Canonical)
+		}
+		externalBackends = append(externalBackends, item)
+	}
+	data.ExternalBackends, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_credential_usage.ExternalBackendAttrTypes()}, externalBackends)
+	diags.Append(d...)
+
+	return diags
+}
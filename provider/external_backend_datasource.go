@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_external_backend"
+)
+
+var _ datasource.DataSource = (*externalBackendDataSource)(nil)
+
+type externalBackendDataSource struct {
+	provider *CycloidProvider
+}
+
+type externalBackendDatasourceModel = datasource_external_backend.ExternalBackendModel
+
+func NewExternalBackendDataSource() datasource.DataSource {
+	return &externalBackendDataSource{}
+}
+
+func (s *externalBackendDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_backend"
+}
+
+func (s *externalBackendDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_external_backend.ExternalBackendDataSourceSchema(ctx)
+}
+
+func (s *externalBackendDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *externalBackendDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data externalBackendDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+	m := s.provider.Client
+
+	var eb *models.ExternalBackend
+	if !data.ExternalBackendID.IsNull() {
+		var err error
+		eb, _, err = m.GetExternalBackend(org, uint32(data.ExternalBackendID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to read external backend %d", data.ExternalBackendID.ValueInt64()), err.Error())
+			return
+		}
+		if !externalBackendMatches(eb, data.FilterModel) {
+			resp.Diagnostics.AddError("external backend does not match the filters", fmt.Sprintf("The external backend %d does not match the filters set.", eb.ID))
+			return
+		}
+	} else {
+		ebs, _, err := m.ListExternalBackends(org)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list external backends", err.Error())
+			return
+		}
+
+		var matches []*models.ExternalBackend
+		for _, e := range ebs {
+			if externalBackendMatches(e, data.FilterModel) {
+				matches = append(matches, e)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError("external backend not found", "No external backend matches the filters set.")
+			return
+		case 1:
+			eb = matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, e := range matches {
+				ids = append(ids, fmt.Sprint(e.ID))
+			}
+			resp.Diagnostics.AddError(
+				"multiple external backends found",
+				fmt.Sprintf("The external backends %s match the filters set, add filters or set external_backend_id to select one.", strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	item, diags := externalBackendToItem(eb)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ExternalBackendModel = item
+	data.Organization = types.StringValue(org)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_external_backend"
+)
+
+// The aws_storage, gcp_storage and swift_storage blocks have generated
+// types, the other engine blocks are plain objects converted here.
+
+// engineBlocks returns the engine blocks of 'data' which are plain objects,
+// by engine.
+func (data *externalBackendResourceModel) engineBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"aws_cloud_watch_logs":  &data.AwsCloudWatchLogs,
+		"aws_remote_tf_state":   &data.AwsRemoteTfState,
+		"azure_cost_export":     &data.AzureCostExport,
+		"azure_remote_tf_state": &data.AzureRemoteTfState,
+		"azure_storage":         &data.AzureStorage,
+		"elasticsearch_logs":    &data.ElasticsearchLogs,
+		"gcp_cost_storage":      &data.GcpCostStorage,
+		"gcp_remote_tf_state":   &data.GcpRemoteTfState,
+		"gitlab_http_storage":   &data.GitlabHttpStorage,
+		"http_storage":          &data.HttpStorage,
+		"swift_remote_tf_state": &data.SwiftRemoteTfState,
+		"vmware_vsphere":        &data.VmwareVsphere,
+	}
+}
+
+func ebEngineAttrTypes(engine string) map[string]attr.Type {
+	switch engine {
+	case "aws_cloud_watch_logs":
+		return resource_external_backend.AwsCloudWatchLogsAttrTypes()
+	case "aws_remote_tf_state":
+		return resource_external_backend.AwsRemoteTfStateAttrTypes()
+	case "azure_cost_export":
+		return resource_external_backend.AzureCostExportAttrTypes()
+	case "azure_remote_tf_state", "azure_storage":
+		return resource_external_backend.AzureStorageAttrTypes()
+	case "elasticsearch_logs":
+		return resource_external_backend.ElasticsearchLogsAttrTypes()
+	case "gcp_cost_storage":
+		return resource_external_backend.GcpCostStorageAttrTypes()
+	case "gcp_remote_tf_state":
+		return resource_external_backend.GcpRemoteTfStateAttrTypes()
+	case "gitlab_http_storage", "http_storage":
+		return resource_external_backend.HttpStorageAttrTypes()
+	case "swift_remote_tf_state":
+		return resource_external_backend.SwiftRemoteTfStateAttrTypes()
+	case "vmware_vsphere":
+		return resource_external_backend.VmwareVsphereAttrTypes()
+	}
+	return nil
+}
+
+func ebEngineConfigurationFromData(ctx context.Context, engine string, obj types.Object) (models.ExternalBackendConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	opts := basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}
+
+	switch engine {
+	case "aws_cloud_watch_logs":
+		var m resource_external_backend.AwsCloudWatchLogsModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.AWSCloudWatchLogs{Region: m.Region.ValueStringPointer()}, diags
+	case "aws_remote_tf_state":
+		var m resource_external_backend.AwsRemoteTfStateModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.AWSRemoteTFState{
+			Bucket:           m.Bucket.ValueStringPointer(),
+			Endpoint:         m.Endpoint.ValueString(),
+			Key:              m.Key.ValueString(),
+			Region:           m.Region.ValueStringPointer(),
+			S3ForcePathStyle: m.S3ForcePathStyle.ValueBool(),
+			SkipVerifySsl:    m.SkipVerifySsl.ValueBool(),
+		}, diags
+	case "azure_cost_export":
+		var m resource_external_backend.AzureCostExportModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.AzureCostExport{
+			BlobServiceURL: m.BlobServiceUrl.ValueString(),
+			Name:           m.Name.ValueString(),
+			Scope:          m.Scope.ValueString(),
+		}, diags
+	case "azure_remote_tf_state":
+		var m resource_external_backend.AzureStorageModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.AzureRemoteTFState{
+			Blob:      m.Blob.ValueString(),
+			Container: m.Container.ValueStringPointer(),
+			Endpoint:  m.Endpoint.ValueString(),
+		}, diags
+	case "azure_storage":
+		var m resource_external_backend.AzureStorageModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.AzureStorage{
+			Blob:      m.Blob.ValueString(),
+			Container: m.Container.ValueStringPointer(),
+			Endpoint:  m.Endpoint.ValueString(),
+		}, diags
+	case "elasticsearch_logs":
+		var m resource_external_backend.ElasticsearchLogsModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		c := &models.ElasticsearchLogs{Version: m.Version.ValueStringPointer(), Urls: []string{}}
+		diags.Append(m.Urls.ElementsAs(ctx, &c.Urls, false)...)
+		if err := json.Unmarshal([]byte(m.Sources.ValueString()), &c.Sources); err != nil {
+			diags.AddError("Invalid elasticsearch_logs sources", fmt.Sprintf("The sources must be JSON encoded: %s", err))
+		}
+		return c, diags
+	case "gcp_cost_storage":
+		var m resource_external_backend.GcpCostStorageModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.GCPCostStorage{
+			Dataset:   m.Dataset.ValueString(),
+			ProjectID: m.ProjectId.ValueString(),
+			Table:     m.Table.ValueString(),
+		}, diags
+	case "gcp_remote_tf_state":
+		var m resource_external_backend.GcpRemoteTfStateModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.GCPRemoteTFState{
+			Bucket: m.Bucket.ValueStringPointer(),
+			Object: m.Object.ValueString(),
+		}, diags
+	case "gitlab_http_storage":
+		var m resource_external_backend.HttpStorageModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.GitLabHTTPStorage{URL: m.Url.ValueStringPointer()}, diags
+	case "http_storage":
+		var m resource_external_backend.HttpStorageModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.HTTPStorage{URL: m.Url.ValueStringPointer()}, diags
+	case "swift_remote_tf_state":
+		var m resource_external_backend.SwiftRemoteTfStateModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.SwiftRemoteTFState{
+			Container:     m.Container.ValueStringPointer(),
+			Object:        m.Object.ValueString(),
+			Region:        m.Region.ValueStringPointer(),
+			SkipVerifySsl: m.SkipVerifySsl.ValueBool(),
+		}, diags
+	case "vmware_vsphere":
+		var m resource_external_backend.VmwareVsphereModel
+		diags.Append(obj.As(ctx, &m, opts)...)
+		return &models.VMwareVsphere{
+			AllowUnverifiedSsl: m.AllowUnverifiedSsl.ValueBool(),
+			Server:             m.Server.ValueString(),
+		}, diags
+	}
+
+	diags.AddError("Unable to read configuration", fmt.Sprintf("Unknown engine %q", engine))
+	return nil, diags
+}
+
+// ebEngineConfigurationToData converts the 'cfg' into its engine block,
+// 'prior' is the previous value of the block.
+func ebEngineConfigurationToData(ctx context.Context, cfg models.ExternalBackendConfiguration, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	engine := enginesFromCY[cfg.Engine()]
+	attrTypes := ebEngineAttrTypes(engine)
+
+	var values map[string]attr.Value
+	switch c := cfg.(type) {
+	case *models.AWSCloudWatchLogs:
+		values = map[string]attr.Value{
+			"region": types.StringPointerValue(c.Region),
+		}
+	case *models.AWSRemoteTFState:
+		values = map[string]attr.Value{
+			"bucket":              types.StringPointerValue(c.Bucket),
+			"endpoint":            types.StringValue(c.Endpoint),
+			"key":                 types.StringValue(c.Key),
+			"region":              types.StringPointerValue(c.Region),
+			"s3_force_path_style": types.BoolValue(c.S3ForcePathStyle),
+			"skip_verify_ssl":     types.BoolValue(c.SkipVerifySsl),
+		}
+	case *models.AzureCostExport:
+		values = map[string]attr.Value{
+			"blob_service_url": types.StringValue(c.BlobServiceURL),
+			"name":             types.StringValue(c.Name),
+			"scope":            types.StringValue(c.Scope),
+		}
+	case *models.AzureRemoteTFState:
+		values = map[string]attr.Value{
+			"blob":      types.StringValue(c.Blob),
+			"container": types.StringPointerValue(c.Container),
+			"endpoint":  types.StringValue(c.Endpoint),
+		}
+	case *models.AzureStorage:
+		values = map[string]attr.Value{
+			"blob":      types.StringValue(c.Blob),
+			"container": types.StringPointerValue(c.Container),
+			"endpoint":  types.StringValue(c.Endpoint),
+		}
+	case *models.ElasticsearchLogs:
+		urls := c.Urls
+		if urls == nil {
+			urls = []string{}
+		}
+		var l types.List
+		l, d = types.ListValueFrom(ctx, types.StringType, urls)
+		diags.Append(d...)
+		sources, err := elasticsearchSourcesToData(c.Sources, prior)
+		if err != nil {
+			diags.AddError("Unable to read elasticsearch_logs sources", err.Error())
+		}
+		values = map[string]attr.Value{
+			"sources": sources,
+			"urls":    l,
+			"version": types.StringPointerValue(c.Version),
+		}
+	case *models.GCPCostStorage:
+		values = map[string]attr.Value{
+			"dataset":    types.StringValue(c.Dataset),
+			"project_id": types.StringValue(c.ProjectID),
+			"table":      types.StringValue(c.Table),
+		}
+	case *models.GCPRemoteTFState:
+		values = map[string]attr.Value{
+			"bucket": types.StringPointerValue(c.Bucket),
+			"object": types.StringValue(c.Object),
+		}
+	case *models.GitLabHTTPStorage:
+		values = map[string]attr.Value{
+			"url": types.StringPointerValue(c.URL),
+		}
+	case *models.HTTPStorage:
+		values = map[string]attr.Value{
+			"url": types.StringPointerValue(c.URL),
+		}
+	case *models.SwiftRemoteTFState:
+		values = map[string]attr.Value{
+			"container":       types.StringPointerValue(c.Container),
+			"object":          types.StringValue(c.Object),
+			"region":          types.StringPointerValue(c.Region),
+			"skip_verify_ssl": types.BoolValue(c.SkipVerifySsl),
+		}
+	case *models.VMwareVsphere:
+		values = map[string]attr.Value{
+			"allow_unverified_ssl": types.BoolValue(c.AllowUnverifiedSsl),
+			"server":               types.StringValue(c.Server),
+		}
+	default:
+		diags.AddError("Unable to read configuration", fmt.Sprintf("Unknown engine %q", cfg.Engine()))
+	}
+	if diags.HasError() {
+		return types.ObjectNull(attrTypes), diags
+	}
+
+	obj, d := types.ObjectValue(attrTypes, values)
+	diags.Append(d...)
+	return obj, diags
+}
+
+// elasticsearchSourcesToData JSON encodes the 'sources', keeping the
+// sources of 'prior' when they only differ by their formatting.
+func elasticsearchSourcesToData(sources map[string]map[string]models.ElasticsearchLogsSourcesAnon, prior types.Object) (types.String, error) {
+	b, err := json.Marshal(sources)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if s, ok := prior.Attributes()["sources"].(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			var priorSources map[string]map[string]models.ElasticsearchLogsSourcesAnon
+			if json.Unmarshal([]byte(s.ValueString()), &priorSources) == nil {
+				pb, err := json.Marshal(priorSources)
+				if err == nil && bytes.Equal(pb, b) {
+					return s, nil
+				}
+			}
+		}
+	}
+
+	return types.StringValue(string(b)), nil
+}
+
+// errNoCredentialSecret is returned by testExternalBackendConnection when the
+// credential is read without its secret, so there is nothing to test with.
+var errNoCredentialSecret = errors.New("the API did not return the secret of the credential, the connection test was skipped")
+
+// testExternalBackendConnection checks from the API that the 'cfg' can be
+// reached with the credential 'credCanonical' for the 'purpose'. The test
+// endpoint takes the secret itself, not the canonical of the credential, so
+// it is read from the raw values GetCredential returns, which requires the
+// permission to read the credential secrets. errNoCredentialSecret is
+// returned instead of testing with an empty secret when they are omitted.
+//
+// The body is a models.TestExternalBackendConnectionInput. The vendored
+// apiclient has no function for this route, so it is not checked against the
+// API spec: move it to apiclient once cycloid-cli has it.
+func testExternalBackendConnection(m apiclient.APIClient, org, purpose, credCanonical string, cfg models.ExternalBackendConfiguration) error {
+	cred, _, err := m.GetCredential(org, credCanonical)
+	if err != nil {
+		return fmt.Errorf("failed to read the credential %q: %w", credCanonical, err)
+	}
+	if !credentialRawHasSecret(cred.Raw) {
+		return errNoCredentialSecret
+	}
+
+	body := &models.TestExternalBackendConnectionInput{
+		Credential: &models.TestConnectionCredential{
+			Raw:  cred.Raw,
+			Type: cred.Type,
+		},
+		Purpose: &purpose,
+	}
+	body.SetConfiguration(cfg)
+
+	_, err = m.GenericRequest(apiclient.Request{
+		Method:       "POST",
+		Organization: &org,
+		Route:        []string{"organizations", org, "external_backends", "test"},
+		Body:         body,
+	}, nil)
+	return err
+}
+
+// credentialRawHasSecret reports whether raw holds at least one of the secret
+// fields of a credential.
+func credentialRawHasSecret(raw *models.CredentialRaw) bool {
+	if raw == nil {
+		return false
+	}
+	return raw.ClientSecret != "" || raw.JSONKey != "" || raw.Password != "" || raw.SecretKey != "" || raw.SSHKey != "" || raw.Raw != nil
+}
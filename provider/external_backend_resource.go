@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -13,7 +18,12 @@ import (
 	"github.com/cycloidio/terraform-provider-cycloid/resource_external_backend"
 )
 
-var _ resource.Resource = (*externalBackendResource)(nil)
+var (
+	_ resource.Resource                   = (*externalBackendResource)(nil)
+	_ resource.ResourceWithImportState    = (*externalBackendResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*externalBackendResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*externalBackendResource)(nil)
+)
 
 func NewExternalBackendResource() resource.Resource {
 	return &externalBackendResource{}
@@ -50,18 +60,98 @@ func (r *externalBackendResource) Configure(ctx context.Context, req resource.Co
 	r.provider = pv
 }
 
-var enginesFromCY = map[string]string{
-	"AWSStorage":   "aws_storage",
-	"GCPStorage":   "gcp_storage",
-	"SwiftStorage": "swift_storage",
+func (r *externalBackendResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data externalBackendResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The engine block set is checked by the validator of the aws_storage
+	// block, the engine has to match it
+	if !data.Engine.IsNull() && !data.Engine.IsUnknown() {
+		engine := data.Engine.ValueString()
+		blocks := map[string]bool{
+			"aws_storage":   !data.AwsStorage.IsNull(),
+			"gcp_storage":   !data.GcpStorage.IsNull(),
+			"swift_storage": !data.SwiftStorage.IsNull(),
+		}
+		for e, obj := range data.engineBlocks() {
+			blocks[e] = !obj.IsNull()
+		}
+		for e, set := range blocks {
+			if set && e != engine {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("engine"),
+					"Engine does not match the engine block",
+					fmt.Sprintf("The engine is %q but the %s block is set, remove the engine or set the %s block.", engine, e, engine),
+				)
+			}
+		}
+	}
+
+	if data.TestConnection.ValueBool() {
+		if !data.Purpose.IsUnknown() && !slices.Contains(resource_external_backend.TestConnectionPurposes, data.Purpose.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("test_connection"),
+				"Connection test not supported",
+				fmt.Sprintf("The connection of an external backend can only be tested for the purposes %s.", strings.Join(resource_external_backend.TestConnectionPurposes, ", ")),
+			)
+		}
+		if data.CredentialCanonical.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("test_connection"),
+				"Missing credential",
+				"The connection of an external backend is tested with its credential, set credential_canonical.",
+			)
+		}
+	}
+}
+
+// ModifyPlan sets engine from the engine block when it is not configured,
+// it would otherwise keep the engine of the state when the block changes.
+func (r *externalBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config externalBackendResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !config.Engine.IsNull() {
+		return
+	}
+
+	if engine := ebEngineFromData(config); engine != "" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("engine"), types.StringValue(engine))...)
+	}
 }
 
-var enginesToCY = map[string]string{
-	"aws_storage":   "AWSStorage",
-	"gcp_storage":   "GCPStorage",
-	"swift_storage": "SwiftStorage",
+var enginesFromCY = map[string]string{
+	"AWSCloudWatchLogs":  "aws_cloud_watch_logs",
+	"AWSRemoteTFState":   "aws_remote_tf_state",
+	"AWSStorage":         "aws_storage",
+	"AzureCostExport":    "azure_cost_export",
+	"AzureRemoteTFState": "azure_remote_tf_state",
+	"AzureStorage":       "azure_storage",
+	"ElasticsearchLogs":  "elasticsearch_logs",
+	"GCPCostStorage":     "gcp_cost_storage",
+	"GCPRemoteTFState":   "gcp_remote_tf_state",
+	"GCPStorage":         "gcp_storage",
+	"GitLabHTTPStorage":  "gitlab_http_storage",
+	"HTTPStorage":        "http_storage",
+	"SwiftRemoteTFState": "swift_remote_tf_state",
+	"SwiftStorage":       "swift_storage",
+	"VMwareVsphere":      "vmware_vsphere",
 }
 
+var enginesToCY = func() map[string]string {
+	m := make(map[string]string, len(enginesFromCY))
+	for cy, engine := range enginesFromCY {
+		m[engine] = cy
+	}
+	return m
+}()
+
 func (r *externalBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data externalBackendResourceModel
 
@@ -79,13 +169,27 @@ func (r *externalBackendResource) Create(ctx context.Context, req resource.Creat
 	cred := data.CredentialCanonical.ValueString()
 	def := data.Default.ValueBool()
 
-	configuration := readEBConfiguration(ctx, resp.Diagnostics, data)
+	configuration, diags := readEBConfiguration(ctx, data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	orgCan := getOrganizationCanonical(*r.provider, data.OrganizationCanonical)
 
+	if data.TestConnection.ValueBool() {
+		err := testExternalBackendConnection(mid, orgCan, purpose, cred, configuration)
+		if errors.Is(err, errNoCredentialSecret) {
+			resp.Diagnostics.AddWarning("External backend connection test skipped", err.Error())
+		} else if err != nil {
+			resp.Diagnostics.AddError(
+				"External backend connection test failed",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	eb, _, err := mid.CreateExternalBackends(orgCan, project, env, purpose, cred, def, configuration)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +199,7 @@ func (r *externalBackendResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	ebCYModelToData(ctx, resp.Diagnostics, orgCan, eb, &data)
+	resp.Diagnostics.Append(ebCYModelToData(ctx, orgCan, eb, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -104,43 +208,74 @@ func (r *externalBackendResource) Create(ctx context.Context, req resource.Creat
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func readEBConfiguration(ctx context.Context, diag diag.Diagnostics, data externalBackendResourceModel) models.ExternalBackendConfiguration {
-	var cfg models.ExternalBackendConfiguration
+func readEBConfiguration(ctx context.Context, data externalBackendResourceModel) (models.ExternalBackendConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	engine := enginesToCY[data.Engine.ValueString()]
+	engine := ebEngineFromData(data)
 
-	switch engine {
+	switch enginesToCY[engine] {
 	case "AWSStorage":
-		cfg = &models.AWSStorage{
+		return &models.AWSStorage{
 			Bucket:           data.AwsStorage.Bucket.ValueStringPointer(),
 			Endpoint:         data.AwsStorage.Endpoint.ValueString(),
 			Key:              data.AwsStorage.Key.ValueString(),
 			Region:           data.AwsStorage.Region.ValueStringPointer(),
 			S3ForcePathStyle: data.AwsStorage.S3ForcePathStyle.ValueBool(),
 			SkipVerifySsl:    data.AwsStorage.SkipVerifySsl.ValueBool(),
-		}
+		}, diags
 	case "GCPStorage":
-		cfg = &models.GCPStorage{
+		return &models.GCPStorage{
 			Bucket: data.GcpStorage.Bucket.ValueStringPointer(),
 			Object: data.GcpStorage.Object.ValueString(),
-		}
+		}, diags
 	case "SwiftStorage":
-		cfg = &models.SwiftStorage{
+		return &models.SwiftStorage{
 			Container:     data.SwiftStorage.Container.ValueStringPointer(),
 			Object:        data.SwiftStorage.Object.ValueString(),
 			Region:        data.SwiftStorage.Region.ValueStringPointer(),
 			SkipVerifySsl: data.SwiftStorage.SkipVerifySsl.ValueBool(),
-		}
-	default:
-		diag.AddError("Unable to read configuration", "Unknown engine")
-		return nil
+		}, diags
+	case "":
+		diags.AddError("Unable to read configuration", fmt.Sprintf("Unknown engine %q", engine))
+		return nil, diags
 	}
 
-	return cfg
+	obj, ok := data.engineBlocks()[engine]
+	if !ok || obj.IsNull() || obj.IsUnknown() {
+		diags.AddError("Unable to read configuration", fmt.Sprintf("The %s block must be set for the engine %q", engine, engine))
+		return nil, diags
+	}
+
+	return ebEngineConfigurationFromData(ctx, engine, *obj)
 }
 
-// credentialCYModelToData converts the 'cred' into the 'credentialResourceModel'
-func ebCYModelToData(ctx context.Context, diag diag.Diagnostics, org string, eb *models.ExternalBackend, data *externalBackendResourceModel) {
+// ebEngineFromData returns the engine set on 'data', or the one of the
+// engine block set.
+func ebEngineFromData(data externalBackendResourceModel) string {
+	if !data.Engine.IsNull() && !data.Engine.IsUnknown() {
+		return data.Engine.ValueString()
+	}
+
+	switch {
+	case !data.AwsStorage.IsNull() && !data.AwsStorage.IsUnknown():
+		return "aws_storage"
+	case !data.GcpStorage.IsNull() && !data.GcpStorage.IsUnknown():
+		return "gcp_storage"
+	case !data.SwiftStorage.IsNull() && !data.SwiftStorage.IsUnknown():
+		return "swift_storage"
+	}
+	for engine, obj := range data.engineBlocks() {
+		if !obj.IsNull() && !obj.IsUnknown() {
+			return engine
+		}
+	}
+	return ""
+}
+
+// ebCYModelToData converts the 'eb' into the 'externalBackendResourceModel'
+func ebCYModelToData(ctx context.Context, org string, eb *models.ExternalBackend, data *externalBackendResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	engine := enginesFromCY[eb.Configuration().Engine()]
 
 	data.OrganizationCanonical = types.StringValue(org)
@@ -155,6 +290,15 @@ func ebCYModelToData(ctx context.Context, diag diag.Diagnostics, org string, eb
 	data.GcpStorage = resource_external_backend.GcpStorageValue{}
 	data.SwiftStorage = resource_external_backend.SwiftStorageValue{}
 
+	// The prior value of the engine block is kept to compare JSON encoded
+	// attributes
+	blocks := data.engineBlocks()
+	prior := make(map[string]types.Object, len(blocks))
+	for e, obj := range blocks {
+		prior[e] = *obj
+		*obj = types.ObjectNull(ebEngineAttrTypes(e))
+	}
+
 	switch engine {
 	case "aws_storage":
 		awsStorage := eb.Configuration().(*models.AWSStorage)
@@ -168,10 +312,10 @@ func ebCYModelToData(ctx context.Context, diag diag.Diagnostics, org string, eb
 			"s3_force_path_style": types.BoolValue(awsStorage.S3ForcePathStyle),
 			"skip_verify_ssl":     types.BoolValue(awsStorage.SkipVerifySsl),
 		}
-		awsStorageEB, diags := resource_external_backend.NewAwsStorageValue(attrTypes, attrValues)
+		awsStorageEB, d := resource_external_backend.NewAwsStorageValue(attrTypes, attrValues)
+		diags.Append(d...)
 		if diags.HasError() {
-			diag.Append(diags...)
-			return
+			return diags
 		}
 		data.AwsStorage = awsStorageEB
 
@@ -184,10 +328,10 @@ func ebCYModelToData(ctx context.Context, diag diag.Diagnostics, org string, eb
 			"bucket": types.StringPointerValue(gcpStorage.Bucket),
 			"object": types.StringValue(gcpStorage.Object),
 		}
-		gcpStorageEB, diags := resource_external_backend.NewGcpStorageValue(attrTypes, attrValues)
+		gcpStorageEB, d := resource_external_backend.NewGcpStorageValue(attrTypes, attrValues)
+		diags.Append(d...)
 		if diags.HasError() {
-			diag.Append(diags...)
-			return
+			return diags
 		}
 		data.GcpStorage = gcpStorageEB
 
@@ -201,16 +345,25 @@ func ebCYModelToData(ctx context.Context, diag diag.Diagnostics, org string, eb
 			"region":          types.StringPointerValue(swiftStorage.Region),
 			"skip_verify_ssl": types.BoolValue(swiftStorage.SkipVerifySsl),
 		}
-		swiftStorageEB, diags := resource_external_backend.NewSwiftStorageValue(attrTypes, attrValues)
+		swiftStorageEB, d := resource_external_backend.NewSwiftStorageValue(attrTypes, attrValues)
+		diags.Append(d...)
 		if diags.HasError() {
-			diag.Append(diags...)
-			return
+			return diags
 		}
 		data.SwiftStorage = swiftStorageEB
 
 	default:
-		diag.AddError("Unable to read configuration", "Unknown engine")
+		obj, ok := blocks[engine]
+		if !ok {
+			diags.AddError("Unable to read configuration", fmt.Sprintf("Unknown engine %q", eb.Configuration().Engine()))
+			return diags
+		}
+		var d diag.Diagnostics
+		*obj, d = ebEngineConfigurationToData(ctx, eb.Configuration(), prior[engine])
+		diags.Append(d...)
 	}
+
+	return diags
 }
 
 func (r *externalBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -241,7 +394,7 @@ func (r *externalBackendResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	ebCYModelToData(ctx, resp.Diagnostics, orgCan, eb, &data)
+	resp.Diagnostics.Append(ebCYModelToData(ctx, orgCan, eb, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -266,7 +419,8 @@ func (r *externalBackendResource) Update(ctx context.Context, req resource.Updat
 
 	orgCan := getOrganizationCanonical(*r.provider, data.OrganizationCanonical)
 
-	configuration := readEBConfiguration(ctx, resp.Diagnostics, data)
+	configuration, diags := readEBConfiguration(ctx, data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -298,6 +452,20 @@ func (r *externalBackendResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	if data.TestConnection.ValueBool() {
+		cred := Coalesce(data.CredentialCanonical.ValueString(), eb.CredentialCanonical)
+		err = testExternalBackendConnection(mid, orgCan, *eb.Purpose, cred, configuration)
+		if errors.Is(err, errNoCredentialSecret) {
+			resp.Diagnostics.AddWarning("External backend connection test skipped", err.Error())
+		} else if err != nil {
+			resp.Diagnostics.AddError(
+				"External backend connection test failed",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	eb, _, err = mid.UpdateExternalBackend(orgCan, eb.ID, *eb.Purpose, eb.CredentialCanonical, *eb.Default, configuration)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -307,7 +475,7 @@ func (r *externalBackendResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	ebCYModelToData(ctx, resp.Diagnostics, orgCan, eb, &data)
+	resp.Diagnostics.Append(ebCYModelToData(ctx, orgCan, eb, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -341,3 +509,24 @@ func (r *externalBackendResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 }
+
+// ImportState supports: terraform import cycloid_external_backend.x <org>:<external_backend_id>
+func (r *externalBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("expected <organization>:<external_backend_id>, got %q", req.ID),
+		)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid external backend ID in import", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_canonical"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external_backend_id"), id)...)
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_external_backends"
+)
+
+var _ datasource.DataSource = (*externalBackendsDataSource)(nil)
+
+type externalBackendsDataSource struct {
+	provider *CycloidProvider
+}
+
+type externalBackendsDatasourceModel = datasource_external_backends.ExternalBackendsModel
+
+func NewExternalBackendsDataSource() datasource.DataSource {
+	return &externalBackendsDataSource{}
+}
+
+func (s *externalBackendsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_backends"
+}
+
+func (s *externalBackendsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_external_backends.ExternalBackendsDataSourceSchema(ctx)
+}
+
+func (s *externalBackendsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *externalBackendsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data externalBackendsDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+
+	ebs, _, err := s.provider.Client.ListExternalBackends(org)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list external backends", err.Error())
+		return
+	}
+
+	items := make([]datasource_external_backends.ExternalBackendModel, 0, len(ebs))
+	for _, eb := range ebs {
+		if !externalBackendMatches(eb, data.FilterModel) {
+			continue
+		}
+		item, diags := externalBackendToItem(eb)
+		resp.Diagnostics.Append(diags...)
+		items = append(items, item)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Organization = types.StringValue(org)
+	data.ExternalBackends, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_external_backends.ExternalBackendAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// externalBackendEngine returns the engine of the 'eb' as named on the
+// cycloid_external_backend resource.
+func externalBackendEngine(eb *models.ExternalBackend) string {
+	if eb.Configuration() == nil {
+		return ""
+	}
+	engine := eb.Configuration().Engine()
+	if e, ok := enginesFromCY[engine]; ok {
+		return e
+	}
+	return engine
+}
+
+// externalBackendMatches returns whether the 'eb' matches all the filters
+// set on 'f'.
+func externalBackendMatches(eb *models.ExternalBackend, f datasource_external_backends.FilterModel) bool {
+	for _, sf := range []struct {
+		filter types.String
+		value  string
+	}{
+		{f.Purpose, ptr.Value(eb.Purpose)},
+		{f.Engine, externalBackendEngine(eb)},
+		{f.ProjectCanonical, eb.ProjectCanonical},
+		{f.EnvironmentCanonical, eb.EnvironmentCanonical},
+		{f.ComponentCanonical, eb.ComponentCanonical},
+	} {
+		if !sf.filter.IsNull() && !sf.filter.IsUnknown() && sf.filter.ValueString() != sf.value {
+			return false
+		}
+	}
+
+	if !f.Default.IsNull() && !f.Default.IsUnknown() && f.Default.ValueBool() != ptr.Value(eb.Default) {
+		return false
+	}
+
+	return true
+}
+
+func externalBackendToItem(eb *models.ExternalBackend) (datasource_external_backends.ExternalBackendModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, err := externalBackendConfigurationMap(eb.Configuration())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to read the configuration of the external backend %d", eb.ID), err.Error())
+	}
+
+	values := make(map[string]attr.Value, len(configuration))
+	for k, v := range configuration {
+		values[k] = types.StringValue(v)
+	}
+	configurationValue, d := types.MapValue(types.StringType, values)
+	diags.Append(d...)
+
+	return datasource_external_backends.ExternalBackendModel{
+		FilterModel: datasource_external_backends.FilterModel{
+			Purpose:              types.StringPointerValue(eb.Purpose),
+			Engine:               types.StringValue(externalBackendEngine(eb)),
+			ProjectCanonical:     types.StringValue(eb.ProjectCanonical),
+			EnvironmentCanonical: types.StringValue(eb.EnvironmentCanonical),
+			ComponentCanonical:   types.StringValue(eb.ComponentCanonical),
+			Default:              types.BoolValue(ptr.Value(eb.Default)),
+		},
+		ExternalBackendID:   types.Int64Value(int64(eb.ID)),
+		CredentialCanonical: types.StringValue(eb.CredentialCanonical),
+		Configuration:       configurationValue,
+	}, diags
+}
+
+// externalBackendConfigurationMap flattens the 'cfg' to strings, values
+// which are not strings are JSON encoded.
+func externalBackendConfigurationMap(cfg models.ExternalBackendConfiguration) (map[string]string, error) {
+	res := make(map[string]string)
+	if cfg == nil {
+		return res, nil
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for k, raw := range fields {
+		// The engine is already exposed on its own
+		if k == "engine" || string(raw) == "null" {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			res[k] = s
+			continue
+		}
+		res[k] = string(raw)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	cycloidapiclient "github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_oidc_group_mappings"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+var _ datasource.DataSource = (*oidcGroupMappingsDataSource)(nil)
+
+type oidcGroupMappingsDataSource struct {
+	provider *CycloidProvider
+}
+
+type oidcGroupMappingsDatasourceModel = datasource_oidc_group_mappings.OidcGroupMappingsModel
+
+func NewOIDCGroupMappingsDataSource() datasource.DataSource {
+	return &oidcGroupMappingsDataSource{}
+}
+
+func (s *oidcGroupMappingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_group_mappings"
+}
+
+func (s *oidcGroupMappingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_oidc_group_mappings.OidcGroupMappingsDataSourceSchema(ctx)
+}
+
+func (s *oidcGroupMappingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *oidcGroupMappingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data oidcGroupMappingsDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+
+	filters, diags := lhsfilter.FromList(ctx, data.Filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, _, err := s.provider.Client.ListOIDCGroupMappings(org, filters...)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list OIDC group mappings in org %q", org), err.Error())
+		return
+	}
+
+	items := make([]datasource_oidc_group_mappings.MappingModel, 0, len(mappings))
+	for _, m := range mappings {
+		item, diags := oidcGroupMappingToItem(ctx, m)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		items = append(items, item)
+	}
+
+	data.Organization = types.StringValue(org)
+	data.Mappings, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_oidc_group_mappings.MappingAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Groups, diags = oidcGroupMappingsToData(ctx, mappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func oidcGroupMappingToItem(ctx context.Context, m *cycloidapiclient.OIDCGroupMapping) (datasource_oidc_group_mappings.MappingModel, diag.Diagnostics) {
+	item := datasource_oidc_group_mappings.MappingModel{
+		ID:            types.Int64Value(int64(m.ID)),
+		GroupName:     types.StringValue(m.GroupName),
+		TeamCanonical: types.StringNull(),
+		TeamName:      types.StringNull(),
+		TeamRoles:     types.ListNull(types.StringType),
+		CreatedAt:     types.Int64Value(int64(m.CreatedAt)),
+		UpdatedAt:     types.Int64Value(int64(m.UpdatedAt)),
+	}
+	if m.Team == nil {
+		return item, nil
+	}
+
+	roles := make([]string, 0, len(m.Team.Roles))
+	for _, r := range m.Team.Roles {
+		if r != nil {
+			roles = append(roles, ptr.Value(r.Canonical))
+		}
+	}
+
+	var diags diag.Diagnostics
+	item.TeamCanonical = types.StringValue(m.Team.Canonical)
+	item.TeamName = types.StringValue(m.Team.Name)
+	item.TeamRoles, diags = types.ListValueFrom(ctx, types.StringType, roles)
+	return item, diags
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	cycloidapiclient "github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_oidc_group_mappings"
+)
+
+var (
+	_ resource.Resource                = (*oidcGroupMappingsResource)(nil)
+	_ resource.ResourceWithImportState = (*oidcGroupMappingsResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*oidcGroupMappingsResource)(nil)
+)
+
+func NewOIDCGroupMappingsResource() resource.Resource {
+	return &oidcGroupMappingsResource{}
+}
+
+type oidcGroupMappingsResource struct {
+	provider *CycloidProvider
+}
+
+type oidcGroupMappingsResourceModel resource_oidc_group_mappings.OidcGroupMappingsModel
+
+func (r *oidcGroupMappingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_group_mappings"
+}
+
+func (r *oidcGroupMappingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_oidc_group_mappings.OidcGroupMappingsResourceSchema(ctx)
+}
+
+func (r *oidcGroupMappingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+// ModifyPlan warns about the mappings of the organization missing from
+// mappings, which apply deletes. On creation they do not show up in the plan,
+// and when cycloid_oidc_group_mapping resources manage them they are created
+// back on their next apply.
+func (r *oidcGroupMappingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.provider == nil || r.provider.Client == nil {
+		return
+	}
+
+	var plan oidcGroupMappingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Organization.IsUnknown() || plan.Mappings.IsUnknown() {
+		return
+	}
+
+	desired, diags := oidcGroupMappingsFromData(ctx, plan.Mappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Listing errors are reported by Read and apply
+	org := getOrganizationCanonical(*r.provider, plan.Organization)
+	current, _, err := r.provider.Client.ListOIDCGroupMappings(org)
+	if err != nil {
+		return
+	}
+
+	var deleted []string
+	for _, c := range current {
+		if c.Team == nil || slices.Contains(desired[c.GroupName], c.Team.Canonical) {
+			continue
+		}
+		deleted = append(deleted, fmt.Sprintf("%s: %s", c.GroupName, c.Team.Canonical))
+	}
+	if len(deleted) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("mappings"),
+		"OIDC group mappings deleted",
+		fmt.Sprintf("These mappings of org %q are not in mappings and are deleted by the apply:\n  - %s\n\nIf cycloid_oidc_group_mapping resources manage them, their next apply creates them back: manage the mappings of an organization with only one of the two resources.", org, strings.Join(deleted, "\n  - ")),
+	)
+}
+
+func (r *oidcGroupMappingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data oidcGroupMappingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oidcGroupMappingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data oidcGroupMappingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	mappings, _, err := r.provider.Client.ListOIDCGroupMappings(org)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list OIDC group mappings in org %q", org), err.Error())
+		return
+	}
+
+	// Every mapping of the organization is read, the ones created outside
+	// Terraform then show up in the plan.
+	var diags diag.Diagnostics
+	data.Organization = types.StringValue(org)
+	data.Mappings, diags = oidcGroupMappingsToData(ctx, mappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oidcGroupMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data oidcGroupMappingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes every mapping of the state, unlike apply which removes every
+// mapping of the organization missing from the plan: a mapping created after
+// the last refresh is kept.
+func (r *oidcGroupMappingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data oidcGroupMappingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	managed, diags := oidcGroupMappingsFromData(ctx, data.Mappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, _, err := r.provider.Client.ListOIDCGroupMappings(org)
+	if err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list OIDC group mappings in org %q", org), err.Error())
+		return
+	}
+
+	for _, m := range mappings {
+		if m.Team == nil || !slices.Contains(managed[m.GroupName], m.Team.Canonical) {
+			continue
+		}
+		if _, err := r.provider.Client.DeleteOIDCGroupMapping(org, m.ID); err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to delete OIDC group mapping %d in org %q", m.ID, org), err.Error())
+			return
+		}
+	}
+}
+
+// ImportState supports: terraform import cycloid_oidc_group_mappings.x <organization>
+func (r *oidcGroupMappingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	data := oidcGroupMappingsResourceModel{
+		Organization: types.StringValue(req.ID),
+		Mappings:     types.MapNull(types.SetType{ElemType: types.StringType}),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply reconciles the mappings of the organization with data: the missing
+// mappings are created first so members do not lose access in between, then
+// the others are deleted, including the ones whose team no longer exists.
+func (r *oidcGroupMappingsResource) apply(ctx context.Context, data *oidcGroupMappingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	m := r.provider.Client
+
+	desired, d := oidcGroupMappingsFromData(ctx, data.Mappings)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, _, err := m.ListOIDCGroupMappings(org)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to list OIDC group mappings in org %q", org), err.Error())
+		return diags
+	}
+
+	for group, teams := range desired {
+		for _, team := range teams {
+			if slices.ContainsFunc(current, func(c *cycloidapiclient.OIDCGroupMapping) bool {
+				return c.GroupName == group && c.Team != nil && c.Team.Canonical == team
+			}) {
+				continue
+			}
+			if _, _, err := m.CreateOIDCGroupMapping(org, group, team); err != nil {
+				diags.AddError(fmt.Sprintf("failed to create OIDC group mapping for group %q in org %q", group, org), err.Error())
+				return diags
+			}
+		}
+	}
+
+	for _, c := range current {
+		if c.Team != nil && slices.Contains(desired[c.GroupName], c.Team.Canonical) {
+			continue
+		}
+		if _, err := m.DeleteOIDCGroupMapping(org, c.ID); err != nil && !isNotFoundError(err) {
+			diags.AddError(fmt.Sprintf("failed to delete OIDC group mapping %d in org %q", c.ID, org), err.Error())
+			return diags
+		}
+	}
+
+	data.Organization = types.StringValue(org)
+	return diags
+}
+
+func oidcGroupMappingsFromData(ctx context.Context, value types.Map) (map[string][]string, diag.Diagnostics) {
+	mappings := make(map[string][]string, len(value.Elements()))
+	if value.IsNull() || value.IsUnknown() {
+		return mappings, nil
+	}
+	diags := value.ElementsAs(ctx, &mappings, false)
+	return mappings, diags
+}
+
+// oidcGroupMappingsToData groups the mappings by group name. Mappings whose
+// team was deleted server-side are ignored.
+func oidcGroupMappingsToData(ctx context.Context, mappings []*cycloidapiclient.OIDCGroupMapping) (types.Map, diag.Diagnostics) {
+	groups := make(map[string][]string)
+	for _, m := range mappings {
+		if m.Team == nil {
+			continue
+		}
+		groups[m.GroupName] = append(groups[m.GroupName], m.Team.Canonical)
+	}
+	return types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, groups)
+}
@@ -3,6 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -18,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = (*organizationAPIKeyResource)(nil)
 	_ resource.ResourceWithImportState = (*organizationAPIKeyResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*organizationAPIKeyResource)(nil)
 )
 
 func NewOrganizationAPIKeyResource() resource.Resource {
@@ -98,7 +102,7 @@ func (r *organizationAPIKeyResource) Read(ctx context.Context, req resource.Read
 	}
 
 	org := getOrganizationCanonical(*r.provider, data.OrganizationCanonical)
-	canonical := data.Canonical.ValueString()
+	canonical := data.keyCanonical()
 
 	apiKey, _, err := r.provider.Client.GetAPIKey(org, canonical)
 	if err != nil {
@@ -134,11 +138,22 @@ func (r *organizationAPIKeyResource) Update(ctx context.Context, req resource.Up
 
 	org := getOrganizationCanonical(*r.provider, data.OrganizationCanonical)
 	// Use state canonical in case plan canonical differs (canonical is immutable via UseStateForUnknown).
-	canonical := stateData.Canonical.ValueString()
+	canonical := stateData.keyCanonical()
 	name := data.Name.ValueString()
 	description := data.Description.ValueString()
 	owner := data.Owner.ValueString()
 
+	// ModifyPlan leaves the token unknown only when the key is rotated
+	if data.Token.IsUnknown() {
+		resp.Diagnostics.Append(r.rotate(ctx, org, canonical, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	body := &models.UpdateAPIKey{
 		Name:        &name,
 		Description: description,
@@ -174,7 +189,7 @@ func (r *organizationAPIKeyResource) Delete(ctx context.Context, req resource.De
 	}
 
 	org := getOrganizationCanonical(*r.provider, data.OrganizationCanonical)
-	canonical := data.Canonical.ValueString()
+	canonical := data.keyCanonical()
 
 	_, err := r.provider.Client.DeleteAPIKey(org, canonical)
 	if err != nil {
@@ -185,17 +200,146 @@ func (r *organizationAPIKeyResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
+func (r *organizationAPIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state organizationAPIKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !apiKeyRotationDue(&plan, &state, time.Now()) {
+		// Keep the token and key even when the state has none, like after an
+		// import, as an unknown token is what rotates the key on Update. The
+		// next rotation follows rotate_after_days, which can change without
+		// rotating the key.
+		plan.Token = state.Token
+		plan.CurrentCanonical = types.StringValue(state.keyCanonical())
+		plan.NextRotationAt = apiKeyNextRotationAt(plan.RotatedAt, plan.RotateAfterDays)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	plan.Token = types.StringUnknown()
+	plan.LastSeven = types.StringUnknown()
+	plan.ID = types.Int64Unknown()
+	plan.CurrentCanonical = types.StringUnknown()
+	plan.RotatedAt = types.StringUnknown()
+	plan.NextRotationAt = types.StringUnknown()
+	plan.LastUsed = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// rotate creates a new API key from 'data', then revokes the key 'canonical'
+// it replaces, so the previous token keeps working until the new one exists.
+// There is no grace period: the previous token stops working in the same
+// apply.
+func (r *organizationAPIKeyResource) rotate(ctx context.Context, org, canonical string, data *organizationAPIKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name := data.Name.ValueString()
+	rules, d := dataToNewRules(ctx, data.Rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	newCanonical := nextAPIKeyCanonical(data.Canonical.ValueString(), canonical)
+	apiKey, _, err := r.provider.Client.CreateAPIKey(org, newCanonical, data.Description.ValueString(), data.Owner.ValueString(), &name, rules)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to create the API key %q rotating %q", newCanonical, canonical), err.Error())
+		return diags
+	}
+
+	diags.Append(apiKeyCYModelToData(ctx, org, apiKey, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The new key is in the state whatever happens next, so a failure to
+	// revoke the previous one must not lose it
+	if _, err := r.provider.Client.DeleteAPIKey(org, canonical); err != nil && !isNotFoundError(err) {
+		diags.AddWarning(
+			fmt.Sprintf("Unable to revoke the previous API key %q", canonical),
+			fmt.Sprintf("The API key was rotated to %q but the previous one could not be deleted, delete it manually: %s", newCanonical, err),
+		)
+	}
+
+	return diags
+}
+
+// keyCanonical returns the canonical of the API key holding the token, which
+// differs from the canonical of the resource once rotated.
+func (data *organizationAPIKeyResourceModel) keyCanonical() string {
+	return Coalesce(data.CurrentCanonical.ValueString(), data.Canonical.ValueString())
+}
+
+// nextAPIKeyCanonical returns the canonical of the API key replacing the
+// 'current' one of the resource 'canonical': canonical-v2, then
+// canonical-v3 and so on.
+func nextAPIKeyCanonical(canonical, current string) string {
+	version := 1
+	if v, ok := strings.CutPrefix(current, canonical+"-v"); ok {
+		if n, err := strconv.Atoi(v); err == nil {
+			version = n
+		}
+	}
+	return fmt.Sprintf("%s-v%d", canonical, version+1)
+}
+
+// apiKeyRotationDue returns whether the API key of the 'state' must be
+// rotated at 'now' for the 'plan': when its rotation_triggers changed or
+// rotate_after_days elapsed.
+func apiKeyRotationDue(plan, state *organizationAPIKeyResourceModel, now time.Time) bool {
+	if !plan.RotationTriggers.Equal(state.RotationTriggers) {
+		return true
+	}
+
+	next := apiKeyNextRotationAt(state.RotatedAt, plan.RotateAfterDays)
+	if next.IsNull() {
+		return false
+	}
+	at, err := time.Parse(time.RFC3339, next.ValueString())
+	return err == nil && !now.Before(at)
+}
+
+// apiKeyNextRotationAt returns when the key created at 'rotatedAt' is due for
+// rotation after 'days', null without rotation period.
+func apiKeyNextRotationAt(rotatedAt types.String, days types.Int64) types.String {
+	if days.IsNull() || rotatedAt.IsNull() {
+		return types.StringNull()
+	}
+	if days.IsUnknown() || rotatedAt.IsUnknown() {
+		return types.StringUnknown()
+	}
+	at, err := time.Parse(time.RFC3339, rotatedAt.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(at.AddDate(0, 0, int(days.ValueInt64())).UTC().Format(time.RFC3339))
+}
+
 func (r *organizationAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("canonical"), req, resp)
 }
 
 // apiKeyCYModelToData maps an APIKey model from the API into the Terraform state model.
-// The token field is only populated on creation; subsequent reads leave it unchanged (UseStateForUnknown).
+// The token field is only populated on creation and rotation; subsequent reads leave it unchanged (UseStateForUnknown).
 func apiKeyCYModelToData(ctx context.Context, org string, apiKey *models.APIKey, data *organizationAPIKeyResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.OrganizationCanonical = types.StringValue(org)
-	data.Canonical = types.StringPointerValue(apiKey.Canonical)
+	// Once rotated, the key has the canonical of the resource with a suffix
+	if data.Canonical.IsNull() || data.Canonical.IsUnknown() {
+		data.Canonical = types.StringPointerValue(apiKey.Canonical)
+	}
+	data.CurrentCanonical = types.StringPointerValue(apiKey.Canonical)
 	data.Name = types.StringPointerValue(apiKey.Name)
 	data.Description = types.StringValue(apiKey.Description)
 	data.LastSeven = types.StringPointerValue(apiKey.LastSeven)
@@ -216,6 +360,20 @@ func apiKeyCYModelToData(ctx context.Context, org string, apiKey *models.APIKey,
 		data.Token = types.StringValue(apiKey.Token)
 	}
 
+	// The API does not return when the key was created, it is tracked from
+	// the first time the provider sees the token. For a key created by an
+	// older version of the provider, or imported, this is the upgrade or the
+	// import, not the creation of the key.
+	if data.RotatedAt.IsNull() || data.RotatedAt.IsUnknown() {
+		data.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+	data.NextRotationAt = apiKeyNextRotationAt(data.RotatedAt, data.RotateAfterDays)
+
+	data.LastUsed = types.StringNull()
+	if apiKey.LastUsed != 0 {
+		data.LastUsed = types.StringValue(time.Unix(int64(apiKey.LastUsed), 0).UTC().Format(time.RFC3339))
+	}
+
 	// Preserve the user's empty-representation for resources (same class of bug
 	// as TFPRO-42 / organization_role): the API returns an empty list whether the
 	// user wrote `resources = []` or omitted it entirely, but since resources is
@@ -0,0 +1,443 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_organization_authentication"
+)
+
+var (
+	_ resource.Resource                = (*organizationAuthenticationResource)(nil)
+	_ resource.ResourceWithImportState = (*organizationAuthenticationResource)(nil)
+)
+
+// authenticationTypes maps the login method blocks to the type of their
+// /authentications/{type} route. It is the route apiclient.GetOIDCIntegration
+// and apiclient.UpdateOIDCIntegration use with AuthenticationOIDC, with the
+// same {"config": ...} envelope; these types are not checked against the API
+// spec.
+var authenticationTypes = map[string]string{
+	"local":    "AuthenticationLocal",
+	"azure_ad": "AuthenticationAzureAD",
+	"github":   "AuthenticationGitHub",
+	"google":   "AuthenticationGoogle",
+	"saml":     "AuthenticationSAML",
+}
+
+func NewOrganizationAuthenticationResource() resource.Resource {
+	return &organizationAuthenticationResource{}
+}
+
+type organizationAuthenticationResource struct {
+	provider *CycloidProvider
+}
+
+type organizationAuthenticationResourceModel resource_organization_authentication.OrganizationAuthenticationModel
+
+func (r *organizationAuthenticationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_authentication"
+}
+
+func (r *organizationAuthenticationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_organization_authentication.OrganizationAuthenticationResourceSchema(ctx)
+}
+
+func (r *organizationAuthenticationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+func (r *organizationAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, configData organizationAuthenticationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	data.Organization = types.StringValue(org)
+
+	resp.Diagnostics.Append(r.apply(ctx, org, &data, configData, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationAuthenticationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationAuthenticationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	data.Organization = types.StringValue(org)
+
+	// An imported resource has no method yet, all the methods known by the
+	// API are then read into the state.
+	imported := true
+	for _, method := range resource_organization_authentication.Methods {
+		if !authenticationMethodValue(&data, method).IsNull() {
+			imported = false
+		}
+	}
+
+	for _, method := range resource_organization_authentication.Methods {
+		if !imported && authenticationMethodValue(&data, method).IsNull() {
+			continue
+		}
+
+		auth, err := getAuthentication(r.provider.Client, org, authenticationTypes[method])
+		if err != nil {
+			if isNotFoundError(err) {
+				setAuthenticationMethodValue(&data, method, types.ObjectNull(authenticationMethodAttrTypes(method)))
+				continue
+			}
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to read %s authentication in org %q", method, org), err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(authenticationToData(ctx, method, auth, &data)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationAuthenticationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, configData, stateData organizationAuthenticationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	data.Organization = types.StringValue(org)
+
+	// Methods removed from the configuration are reset as on Delete.
+	for _, method := range resource_organization_authentication.Methods {
+		if authenticationMethodValue(&data, method).IsNull() && !authenticationMethodValue(&stateData, method).IsNull() {
+			if err := resetAuthentication(r.provider.Client, org, method); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("failed to reset %s authentication in org %q", method, org), err.Error())
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, org, &data, configData, &stateData)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete has no API counterpart. Local login is enabled back first, then the
+// managed SSO methods are disabled, so the organization is never left without
+// a login method. Server-side configuration and secrets are preserved.
+func (r *organizationAuthenticationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data organizationAuthenticationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	for _, method := range resource_organization_authentication.Methods {
+		if authenticationMethodValue(&data, method).IsNull() {
+			continue
+		}
+		if err := resetAuthentication(r.provider.Client, org, method); err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to reset %s authentication in org %q", method, org), err.Error())
+			return
+		}
+	}
+}
+
+// ImportState supports: terraform import cycloid_organization_authentication.x <organization>
+func (r *organizationAuthenticationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	data := organizationAuthenticationResourceModel{
+		Organization:   types.StringValue(req.ID),
+		SecretsVersion: types.Int64Null(),
+		Local:          types.ObjectNull(resource_organization_authentication.LocalAttrTypes()),
+		AzureAD:        types.ObjectNull(resource_organization_authentication.AzureADAttrTypes()),
+		GitHub:         types.ObjectNull(resource_organization_authentication.GitHubAttrTypes()),
+		Google:         types.ObjectNull(resource_organization_authentication.GoogleAttrTypes()),
+		SAML:           types.ObjectNull(resource_organization_authentication.SAMLAttrTypes()),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends every method of data to the API and sets the result back in
+// data. The write-only secrets are only available in configData, they are
+// sent on create, for a method not in stateData yet, or when secrets_version
+// changed from stateData; otherwise the stored secrets are kept.
+func (r *organizationAuthenticationResource) apply(ctx context.Context, org string, data *organizationAuthenticationResourceModel, configData organizationAuthenticationResourceModel, stateData *organizationAuthenticationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, method := range authenticationApplyOrder(data) {
+		sendSecrets := stateData == nil ||
+			authenticationMethodValue(stateData, method).IsNull() ||
+			!data.SecretsVersion.Equal(stateData.SecretsVersion)
+
+		config, d := authenticationConfig(ctx, method, data, configData, sendSecrets)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		auth, err := updateAuthentication(r.provider.Client, org, config)
+		if err == nil && auth == nil {
+			// Some versions of the API answer the PUT without the config.
+			auth, err = getAuthentication(r.provider.Client, org, authenticationTypes[method])
+		}
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to update %s authentication in org %q", method, org), err.Error())
+			return diags
+		}
+
+		diags.Append(authenticationToData(ctx, method, auth, data)...)
+	}
+
+	return diags
+}
+
+// authenticationApplyOrder returns the methods set in data in the order apply
+// sends them: the enabled SSO methods first, then the disabled ones, so the
+// organization keeps a login method while the others are disabled, and local
+// login last.
+func authenticationApplyOrder(data *organizationAuthenticationResourceModel) []string {
+	var enabled, disabled []string
+	for _, method := range resource_organization_authentication.Methods {
+		value := authenticationMethodValue(data, method)
+		if value.IsNull() || method == "local" {
+			continue
+		}
+		if v, ok := value.Attributes()["enabled"].(types.Bool); ok && !v.ValueBool() {
+			disabled = append(disabled, method)
+		} else {
+			enabled = append(enabled, method)
+		}
+	}
+
+	order := append(enabled, disabled...)
+	if !data.Local.IsNull() {
+		order = append(order, "local")
+	}
+	return order
+}
+
+func getAuthentication(m apiclient.APIClient, org, authType string) (models.AuthenticationConfig, error) {
+	var result models.Authentication
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org, "authentications", authType},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Config(), nil
+}
+
+// updateAuthentication creates-or-updates an authentication method. config
+// must include "type" and "enabled", the backend merges the other keys with
+// the stored ones and an absent secret preserves the stored secret.
+func updateAuthentication(m apiclient.APIClient, org string, config map[string]interface{}) (models.AuthenticationConfig, error) {
+	var result models.Authentication
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "PUT",
+		Organization: &org,
+		Route:        []string{"organizations", org, "authentications", config["type"].(string)},
+		Body:         map[string]interface{}{"config": config},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Config(), nil
+}
+
+// resetAuthentication disables an SSO method, or enables local login back.
+func resetAuthentication(m apiclient.APIClient, org, method string) error {
+	_, err := updateAuthentication(m, org, map[string]interface{}{
+		"type":    authenticationTypes[method],
+		"enabled": method == "local",
+	})
+	return err
+}
+
+// authenticationConfig builds the body of updateAuthentication for method.
+// Optional fields are only sent when set so that the stored values are kept
+// otherwise, the same goes for the write-only secrets read from configData,
+// which are only sent when sendSecrets is true.
+func authenticationConfig(ctx context.Context, method string, data *organizationAuthenticationResourceModel, configData organizationAuthenticationResourceModel, sendSecrets bool) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cfg := map[string]interface{}{
+		"type": authenticationTypes[method],
+	}
+	setString := func(key string, v types.String) {
+		if !v.IsNull() && !v.IsUnknown() {
+			cfg[key] = v.ValueString()
+		}
+	}
+
+	switch method {
+	case "local":
+		var local resource_organization_authentication.LocalModel
+		diags.Append(data.Local.As(ctx, &local, basetypes.ObjectAsOptions{})...)
+		cfg["enabled"] = local.Enabled.ValueBool()
+	case "azure_ad":
+		var azure resource_organization_authentication.AzureADModel
+		diags.Append(data.AzureAD.As(ctx, &azure, basetypes.ObjectAsOptions{})...)
+		cfg["enabled"] = azure.Enabled.ValueBool()
+		setString("client_id", azure.ClientID)
+		setString("tenant_id", azure.TenantID)
+	case "github":
+		var github, configGithub resource_organization_authentication.GitHubModel
+		diags.Append(data.GitHub.As(ctx, &github, basetypes.ObjectAsOptions{})...)
+		diags.Append(configData.GitHub.As(ctx, &configGithub, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+		cfg["enabled"] = github.Enabled.ValueBool()
+		setString("client_id", github.ClientID)
+		setString("host_address", github.HostAddress)
+		if v := configGithub.ClientSecretWo.ValueString(); sendSecrets && v != "" {
+			cfg["client_secret_id"] = v
+		}
+	case "google":
+		var google resource_organization_authentication.GoogleModel
+		diags.Append(data.Google.As(ctx, &google, basetypes.ObjectAsOptions{})...)
+		cfg["enabled"] = google.Enabled.ValueBool()
+		setString("client_id", google.ClientID)
+	case "saml":
+		var saml resource_organization_authentication.SAMLModel
+		diags.Append(data.SAML.As(ctx, &saml, basetypes.ObjectAsOptions{})...)
+		cfg["enabled"] = saml.Enabled.ValueBool()
+		setString("provider", saml.Provider)
+		setString("sso_url", saml.SsoURL)
+	}
+
+	return cfg, diags
+}
+
+// authenticationToData sets the block of method from the API config. Empty
+// optional fields are stored as null and the write-only secret is never set.
+func authenticationToData(ctx context.Context, method string, config models.AuthenticationConfig, data *organizationAuthenticationResourceModel) diag.Diagnostics {
+	if config == nil {
+		return nil
+	}
+
+	var (
+		value types.Object
+		diags diag.Diagnostics
+	)
+	enabled := types.BoolValue(ptr.Value(config.Enabled()))
+
+	switch c := config.(type) {
+	case *models.AuthenticationLocal:
+		value, diags = types.ObjectValueFrom(ctx, resource_organization_authentication.LocalAttrTypes(), resource_organization_authentication.LocalModel{
+			Enabled: enabled,
+		})
+	case *models.AuthenticationAzureAD:
+		value, diags = types.ObjectValueFrom(ctx, resource_organization_authentication.AzureADAttrTypes(), resource_organization_authentication.AzureADModel{
+			Enabled:  enabled,
+			ClientID: types.StringPointerValue(nilIfEmpty(c.ClientID)),
+			TenantID: types.StringPointerValue(nilIfEmpty(c.TenantID)),
+		})
+	case *models.AuthenticationGitHub:
+		value, diags = types.ObjectValueFrom(ctx, resource_organization_authentication.GitHubAttrTypes(), resource_organization_authentication.GitHubModel{
+			Enabled:        enabled,
+			ClientID:       types.StringPointerValue(nilIfEmpty(c.ClientID)),
+			HostAddress:    types.StringPointerValue(nilIfEmpty(c.HostAddress)),
+			ClientSecretWo: types.StringNull(),
+			HasSecret:      types.BoolValue(ptr.Value(c.HasSecret)),
+		})
+	case *models.AuthenticationGoogle:
+		value, diags = types.ObjectValueFrom(ctx, resource_organization_authentication.GoogleAttrTypes(), resource_organization_authentication.GoogleModel{
+			Enabled:  enabled,
+			ClientID: types.StringPointerValue(nilIfEmpty(c.ClientID)),
+		})
+	case *models.AuthenticationSAML:
+		value, diags = types.ObjectValueFrom(ctx, resource_organization_authentication.SAMLAttrTypes(), resource_organization_authentication.SAMLModel{
+			Enabled:  enabled,
+			Provider: types.StringPointerValue(nilIfEmpty(c.Provider)),
+			SsoURL:   types.StringPointerValue(nilIfEmpty(string(c.SsoURL))),
+		})
+	default:
+		diags.AddError(
+			fmt.Sprintf("unexpected %s authentication", method),
+			fmt.Sprintf("The API returned an authentication of type %q.", config.Type()),
+		)
+		return diags
+	}
+
+	setAuthenticationMethodValue(data, method, value)
+	return diags
+}
+
+func authenticationMethodValue(data *organizationAuthenticationResourceModel, method string) types.Object {
+	switch method {
+	case "local":
+		return data.Local
+	case "azure_ad":
+		return data.AzureAD
+	case "github":
+		return data.GitHub
+	case "google":
+		return data.Google
+	default:
+		return data.SAML
+	}
+}
+
+func setAuthenticationMethodValue(data *organizationAuthenticationResourceModel, method string, value types.Object) {
+	switch method {
+	case "local":
+		data.Local = value
+	case "azure_ad":
+		data.AzureAD = value
+	case "github":
+		data.GitHub = value
+	case "google":
+		data.Google = value
+	default:
+		data.SAML = value
+	}
+}
+
+func authenticationMethodAttrTypes(method string) map[string]attr.Type {
+	switch method {
+	case "local":
+		return resource_organization_authentication.LocalAttrTypes()
+	case "azure_ad":
+		return resource_organization_authentication.AzureADAttrTypes()
+	case "github":
+		return resource_organization_authentication.GitHubAttrTypes()
+	case "google":
+		return resource_organization_authentication.GoogleAttrTypes()
+	default:
+		return resource_organization_authentication.SAMLAttrTypes()
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_organization_invitations"
+)
+
+var _ datasource.DataSource = (*organizationInvitationsDataSource)(nil)
+
+type organizationInvitationsDataSource struct {
+	provider *CycloidProvider
+}
+
+type organizationInvitationsDatasourceModel = datasource_organization_invitations.OrganizationInvitationsModel
+
+func NewOrganizationInvitationsDataSource() datasource.DataSource {
+	return &organizationInvitationsDataSource{}
+}
+
+func (s *organizationInvitationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitations"
+}
+
+func (s *organizationInvitationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_invitations.OrganizationInvitationsDataSourceSchema(ctx)
+}
+
+func (s *organizationInvitationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *organizationInvitationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data organizationInvitationsDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+
+	invites, _, err := s.provider.Client.ListInvites(org)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list the invitations", err.Error())
+		return
+	}
+
+	if data.Status.ValueString() == invitationExpired && data.InvitationValidityDays.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("invitation_validity_days"),
+			"Missing invitation validity period",
+			"invitation_validity_days is required to list the expired invitations.",
+		)
+		return
+	}
+
+	now := time.Now()
+	validity := invitationValidity(data.InvitationValidityDays)
+	items := make([]datasource_organization_invitations.InvitationModel, 0, len(invites))
+	for _, m := range invites {
+		status, expiresAt := memberInvitation(m, validity, now)
+		if !data.Status.IsNull() && data.Status.ValueString() != status {
+			continue
+		}
+
+		item := datasource_organization_invitations.InvitationModel{
+			MemberID:  types.Int64Value(int64(ptr.Value(m.ID))),
+			Email:     types.StringValue(m.InvitationEmail.String()),
+			Role:      types.StringNull(),
+			InvitedBy: types.StringNull(),
+			SentAt:    types.StringNull(),
+			ExpiresAt: types.StringNull(),
+			Status:    types.StringValue(status),
+		}
+		if m.Role != nil {
+			item.Role = types.StringPointerValue(m.Role.Canonical)
+		}
+		if m.InvitedBy != nil {
+			item.InvitedBy = types.StringValue(m.InvitedBy.Username)
+		}
+		if sentAt := invitationSentAt(m); sentAt != nil {
+			item.SentAt = types.StringValue(sentAt.UTC().Format(time.RFC3339))
+		}
+		if expiresAt != nil {
+			item.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+		}
+		items = append(items, item)
+	}
+
+	var diags diag.Diagnostics
+	data.Organization = types.StringValue(org)
+	data.Invitations, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_organization_invitations.InvitationAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
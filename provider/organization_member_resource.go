@@ -3,16 +3,28 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
 	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
 	"github.com/cycloidio/terraform-provider-cycloid/resource_organization_member"
 )
 
-var _ resource.Resource = (*organizationMemberResource)(nil)
+var (
+	_ resource.Resource                   = (*organizationMemberResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*organizationMemberResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*organizationMemberResource)(nil)
+)
+
+// invitationExpired is the status of a pending invitation older than its
+// validity period, the API has no such state
+const invitationExpired = "expired"
 
 func NewOrganizationMemberResource() resource.Resource {
 	return &organizationMemberResource{}
@@ -49,6 +61,24 @@ func (r *organizationMemberResource) Configure(ctx context.Context, req resource
 	r.provider = pv
 }
 
+func (r *organizationMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data organizationMemberResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The expiry of the invitations is not known from the API, without the
+	// validity period none of them is ever expired
+	if data.ResendExpiredInvitation.ValueBool() && data.InvitationValidityDays.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("invitation_validity_days"),
+			"Missing invitation validity period",
+			"invitation_validity_days is required when resend_expired_invitation is true.",
+		)
+	}
+}
+
 func (r *organizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data organizationMemberResourceModel
 
@@ -100,19 +130,152 @@ func orgMemberCYModelToData(org string, m *models.MemberOrg, data *organizationM
 	var diags diag.Diagnostics
 
 	email := m.Email
-	if m.InvitationState == "pending" {
+	if m.InvitationState == models.MemberOrgInvitationStatePending {
 		email = m.InvitationEmail
 	}
 
+	status, expiresAt := memberInvitation(m, invitationValidity(data.InvitationValidityDays), time.Now())
+
 	data.OrganizationCanonical = types.StringValue(org)
 	data.MemberId = types.Int64Value(int64(*m.ID))
 	data.MemberCanonical = types.StringValue(m.Username)
 	data.Email = types.StringValue(string(email))
 	data.RoleCanonical = types.StringValue(*m.Role.Canonical)
+	data.InvitationStatus = types.StringValue(status)
+	data.InvitationExpiresAt = types.StringNull()
+	if expiresAt != nil {
+		data.InvitationExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+	}
+	data.InvitedBy = types.StringNull()
+	if m.InvitedBy != nil {
+		data.InvitedBy = types.StringValue(m.InvitedBy.Username)
+	}
 
 	return diags
 }
 
+// memberInvitation returns the status of the invitation of the 'm' at 'now'
+// and, for a pending or expired one, when it expires. The API has neither
+// expired state nor expiry, an invitation expires 'validity' after it was
+// last sent, and never when 'validity' is 0.
+func memberInvitation(m *models.MemberOrg, validity time.Duration, now time.Time) (string, *time.Time) {
+	switch m.InvitationState {
+	case models.MemberOrgInvitationStatePending:
+	case "":
+		// Members which joined without an invitation have no state
+		return models.MemberOrgInvitationStateAccepted, nil
+	default:
+		return m.InvitationState, nil
+	}
+
+	sentAt := invitationSentAt(m)
+	if sentAt == nil || validity == 0 {
+		return models.MemberOrgInvitationStatePending, nil
+	}
+
+	expiresAt := sentAt.Add(validity)
+	if now.After(expiresAt) {
+		return invitationExpired, &expiresAt
+	}
+	return models.MemberOrgInvitationStatePending, &expiresAt
+}
+
+// invitationValidity returns the validity period of the invitations from
+// the 'days' configured, 0 when not set.
+func invitationValidity(days types.Int64) time.Duration {
+	return time.Duration(days.ValueInt64()) * 24 * time.Hour
+}
+
+// invitationSentAt returns when the invitation of the 'm' was last sent.
+func invitationSentAt(m *models.MemberOrg) *time.Time {
+	sentAt := m.InvitationResentAt
+	if sentAt == nil {
+		sentAt = m.InvitedAt
+	}
+	if sentAt == nil {
+		return nil
+	}
+	t := time.Unix(int64(*sentAt), 0)
+	return &t
+}
+
+// resendInvitation sends the invitation of the member 'id' to 'email' again.
+// The API cannot send an invitation again, so a new one is sent and the
+// expired one is deleted after it. When the API rejects the new invitation as
+// a duplicate, the expired one is deleted first, and the error tells the
+// member ID was lost if the new invitation then fails. A member is returned
+// with an error when the new invitation was sent but the expired one could
+// not be deleted.
+func resendInvitation(m apiclient.APIClient, org string, id uint32, email, role string) (*models.MemberOrg, error) {
+	member, _, err := m.InviteMember(org, email, role)
+	if err == nil {
+		if _, err := m.DeleteMember(org, id); err != nil && !isNotFoundError(err) {
+			return member, fmt.Errorf("the invitation was sent again as member %d, but the expired invitation, member %d, could not be deleted: %w", ptr.Value(member.ID), id, err)
+		}
+		return member, nil
+	}
+	if !isConflictError(err) {
+		return nil, err
+	}
+
+	if _, err := m.DeleteMember(org, id); err != nil && !isNotFoundError(err) {
+		return nil, fmt.Errorf("failed to delete the expired invitation: %w", err)
+	}
+	member, _, err = m.InviteMember(org, email, role)
+	if err != nil {
+		return nil, fmt.Errorf("the expired invitation, member %d, was deleted but the new one could not be sent, the next apply invites the member again: %w", id, err)
+	}
+	return member, nil
+}
+
+func (r *organizationMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to send again on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state organizationMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The status and expiry depend on the validity period
+	if !plan.InvitationValidityDays.Equal(state.InvitationValidityDays) {
+		plan.InvitationStatus = types.StringUnknown()
+		plan.InvitationExpiresAt = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	if !plan.ResendExpiredInvitation.ValueBool() || state.InvitationStatus.ValueString() != invitationExpired {
+		return
+	}
+
+	var configMemberID types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("member_id"), &configMemberID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configMemberID.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("resend_expired_invitation"),
+			"Expired invitation not sent again",
+			fmt.Sprintf("The invitation of %q expired but cannot be sent again while member_id is set, as sending it again changes the member ID.", state.Email.ValueString()),
+		)
+		return
+	}
+
+	// Sending the invitation again replaces the member
+	plan.MemberId = types.Int64Unknown()
+	plan.MemberCanonical = types.StringUnknown()
+	plan.InvitationStatus = types.StringUnknown()
+	plan.InvitationExpiresAt = types.StringUnknown()
+	plan.InvitedBy = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *organizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data organizationMemberResourceModel
 
@@ -148,10 +311,11 @@ func (r *organizationMemberResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *organizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data organizationMemberResourceModel
+	var data, state organizationMemberResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -165,13 +329,28 @@ func (r *organizationMemberResource) Update(ctx context.Context, req resource.Up
 
 	// If there's no memberID, try to get it from the prior state
 	if memberID == 0 {
-		var plandata organizationMemberResourceModel
-		// Read Terraform prior state data into the model
-		resp.Diagnostics.Append(req.State.Get(ctx, &plandata)...)
-		if resp.Diagnostics.HasError() {
+		memberID = state.MemberId.ValueInt64()
+	}
+
+	if data.ResendExpiredInvitation.ValueBool() && state.InvitationStatus.ValueString() == invitationExpired && data.MemberId.IsUnknown() {
+		m, err := resendInvitation(mid, orgCan, uint32(memberID), data.Email.ValueString(), roleCan)
+		if err != nil && m == nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to send the invitation of %q again", data.Email.ValueString()),
+				err.Error(),
+			)
 			return
 		}
-		memberID = plandata.MemberId.ValueInt64()
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Expired invitation of %q not deleted", data.Email.ValueString()),
+				err.Error(),
+			)
+		}
+
+		orgMemberCYModelToData(orgCan, m, &data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
 	}
 
 	m, _, err := mid.UpdateMember(orgCan, uint32(memberID), roleCan)
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_organization_naming_rules"
+)
+
+var (
+	_ resource.Resource                = (*organizationNamingRulesResource)(nil)
+	_ resource.ResourceWithImportState = (*organizationNamingRulesResource)(nil)
+)
+
+func NewOrganizationNamingRulesResource() resource.Resource {
+	return &organizationNamingRulesResource{}
+}
+
+type organizationNamingRulesResource struct {
+	provider *CycloidProvider
+}
+
+type organizationNamingRulesResourceModel resource_organization_naming_rules.OrganizationNamingRulesModel
+
+func (r *organizationNamingRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_naming_rules"
+}
+
+func (r *organizationNamingRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_organization_naming_rules.OrganizationNamingRulesResourceSchema(ctx)
+}
+
+func (r *organizationNamingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+func (r *organizationNamingRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data organizationNamingRulesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationNamingRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationNamingRulesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	rules, err := getOrganizationNamingRules(r.provider.Client, org)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read the naming rules of org %q", org), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(organizationNamingRulesToData(ctx, org, rules, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationNamingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data organizationNamingRulesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete has no API counterpart: the rules are cleared so that any canonical
+// is accepted again.
+func (r *organizationNamingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data organizationNamingRulesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	_, err := updateOrganization(r.provider, org, func(body map[string]interface{}) {
+		for field := range (&organizationNamingRules{}).fields() {
+			body[field] = ""
+		}
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to clear the naming rules of org %q", org), err.Error())
+	}
+}
+
+// ImportState supports: terraform import cycloid_organization_naming_rules.x <organization>
+func (r *organizationNamingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	data := organizationNamingRulesResourceModel{
+		Organization: types.StringValue(req.ID),
+		Project:      types.ObjectNull(resource_organization_naming_rules.RuleAttrTypes()),
+		Environment:  types.ObjectNull(resource_organization_naming_rules.RuleAttrTypes()),
+		Component:    types.ObjectNull(resource_organization_naming_rules.RuleAttrTypes()),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends every rule of data, a rule without block is cleared.
+func (r *organizationNamingRulesResource) apply(ctx context.Context, data *organizationNamingRulesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	body := make(map[string]interface{}, 6)
+	for prefix, value := range map[string]types.Object{
+		"project":   data.Project,
+		"env":       data.Environment,
+		"component": data.Component,
+	} {
+		var rule resource_organization_naming_rules.RuleModel
+		if !value.IsNull() && !value.IsUnknown() {
+			diags.Append(value.As(ctx, &rule, basetypes.ObjectAsOptions{})...)
+		}
+		body[prefix+"_regex"] = rule.Regex.ValueString()
+		body[prefix+"_message"] = rule.Message.ValueString()
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := updateOrganization(r.provider, org, func(b map[string]interface{}) {
+		for field, value := range body {
+			b[field] = value
+		}
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to update the naming rules of org %q", org), err.Error())
+		return diags
+	}
+
+	rules, err := getOrganizationNamingRules(r.provider.Client, org)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to read the naming rules of org %q", org), err.Error())
+		return diags
+	}
+
+	diags.Append(organizationNamingRulesToData(ctx, org, rules, data)...)
+	return diags
+}
+
+// organizationNamingRulesToData sets the rules of data from the API, a rule
+// without regex nor message is stored as a null block. Rules not returned by
+// the API keep the rules of data as sent.
+func organizationNamingRulesToData(ctx context.Context, org string, rules *organizationNamingRules, data *organizationNamingRulesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Organization = types.StringValue(org)
+	if !rules.returned() {
+		return diags
+	}
+
+	rule := func(regex, message string) types.Object {
+		if regex == "" && message == "" {
+			return types.ObjectNull(resource_organization_naming_rules.RuleAttrTypes())
+		}
+		v, d := types.ObjectValueFrom(ctx, resource_organization_naming_rules.RuleAttrTypes(), resource_organization_naming_rules.RuleModel{
+			Regex:   types.StringPointerValue(nilIfEmpty(regex)),
+			Message: types.StringPointerValue(nilIfEmpty(message)),
+		})
+		diags.Append(d...)
+		return v
+	}
+
+	data.Project = rule(ptr.Value(rules.ProjectRegex), ptr.Value(rules.ProjectMessage))
+	data.Environment = rule(ptr.Value(rules.EnvRegex), ptr.Value(rules.EnvMessage))
+	data.Component = rule(ptr.Value(rules.ComponentRegex), ptr.Value(rules.ComponentMessage))
+	return diags
+}
@@ -378,12 +378,13 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 			return
 		}
 	} else {
-		opts := apiclient.UpdateOrganizationOpts{}
-		if !orgPlan.CanChildrenManageOidcMapping.IsNull() && !orgPlan.CanChildrenManageOidcMapping.IsUnknown() {
-			v := orgPlan.CanChildrenManageOidcMapping.ValueBool()
-			opts.CanChildrenManageOidcMapping = &v
-		}
-		org, _, err = m.UpdateOrganization(canonical, name, opts)
+		// The settings managed by other resources, such as MFA, are kept
+		org, err = updateOrganization(r.provider, canonical, func(body map[string]interface{}) {
+			body["name"] = name
+			if !orgPlan.CanChildrenManageOidcMapping.IsNull() && !orgPlan.CanChildrenManageOidcMapping.IsUnknown() {
+				body["can_children_manage_oidc_mapping"] = orgPlan.CanChildrenManageOidcMapping.ValueBool()
+			}
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Failed to update org %s", canonical),
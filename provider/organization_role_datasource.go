@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_organization_role"
+)
+
+var _ datasource.DataSource = (*organizationRoleDataSource)(nil)
+
+type organizationRoleDataSource struct {
+	provider *CycloidProvider
+}
+
+type organizationRoleDatasourceModel = datasource_organization_role.OrganizationRoleModel
+
+func NewOrganizationRoleDataSource() datasource.DataSource {
+	return &organizationRoleDataSource{}
+}
+
+func (s *organizationRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_role"
+}
+
+func (s *organizationRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_role.OrganizationRoleDataSourceSchema(ctx)
+}
+
+func (s *organizationRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *organizationRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data organizationRoleDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+	canonical := data.Canonical.ValueString()
+
+	role, _, err := s.provider.Client.GetRole(org, canonical)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read the role %q", canonical), err.Error())
+		return
+	}
+
+	item, diags := roleToItem(ctx, role)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RoleModel = item
+	data.Organization = types.StringValue(org)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_organization_roles"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_policy_document"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+var _ datasource.DataSource = (*organizationRolesDataSource)(nil)
+
+type organizationRolesDataSource struct {
+	provider *CycloidProvider
+}
+
+type organizationRolesDatasourceModel = datasource_organization_roles.OrganizationRolesModel
+
+func NewOrganizationRolesDataSource() datasource.DataSource {
+	return &organizationRolesDataSource{}
+}
+
+func (s *organizationRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_roles"
+}
+
+func (s *organizationRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_roles.OrganizationRolesDataSourceSchema(ctx)
+}
+
+func (s *organizationRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *organizationRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data organizationRolesDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+
+	filters, diags := lhsfilter.FromList(ctx, data.Filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, _, err := s.provider.Client.ListRoles(org, filters...)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list roles", err.Error())
+		return
+	}
+
+	items := make([]datasource_organization_roles.RoleModel, 0, len(roles))
+	for _, r := range roles {
+		item, diags := roleToItem(ctx, r)
+		resp.Diagnostics.Append(diags...)
+		items = append(items, item)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Organization = types.StringValue(org)
+	data.Roles, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_organization_roles.RoleAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func roleToItem(ctx context.Context, role *models.Role) (datasource_organization_roles.RoleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := make([]attr.Value, 0, len(role.Rules))
+	for _, r := range role.Rules {
+		if r == nil {
+			continue
+		}
+		resources := r.Resources
+		if resources == nil {
+			resources = []string{}
+		}
+		l, d := types.ListValueFrom(ctx, types.StringType, resources)
+		diags.Append(d...)
+		v, d := types.ObjectValue(datasource_policy_document.RuleAttrTypes(), map[string]attr.Value{
+			"action":    types.StringPointerValue(r.Action),
+			"effect":    types.StringPointerValue(r.Effect),
+			"resources": l,
+		})
+		diags.Append(d...)
+		rules = append(rules, v)
+	}
+	rulesValue, d := types.ListValue(types.ObjectType{AttrTypes: datasource_policy_document.RuleAttrTypes()}, rules)
+	diags.Append(d...)
+
+	return datasource_organization_roles.RoleModel{
+		Canonical:   types.StringPointerValue(role.Canonical),
+		Name:        types.StringPointerValue(role.Name),
+		RoleID:      types.Int64Value(int64(ptr.Value(role.ID))),
+		Description: types.StringPointerValue(role.Description),
+		Default:     types.BoolValue(ptr.Value(role.Default)),
+		Rules:       rulesValue,
+	}, diags
+}
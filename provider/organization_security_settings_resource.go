@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_organization_security_settings"
+)
+
+var (
+	_ resource.Resource                = (*organizationSecuritySettingsResource)(nil)
+	_ resource.ResourceWithImportState = (*organizationSecuritySettingsResource)(nil)
+)
+
+func NewOrganizationSecuritySettingsResource() resource.Resource {
+	return &organizationSecuritySettingsResource{}
+}
+
+type organizationSecuritySettingsResource struct {
+	provider *CycloidProvider
+}
+
+type organizationSecuritySettingsResourceModel resource_organization_security_settings.OrganizationSecuritySettingsModel
+
+func (r *organizationSecuritySettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_security_settings"
+}
+
+func (r *organizationSecuritySettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_organization_security_settings.OrganizationSecuritySettingsResourceSchema(ctx)
+}
+
+func (r *organizationSecuritySettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+func (r *organizationSecuritySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data organizationSecuritySettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	o, err := updateOrganizationSecuritySettings(r.provider, org, &data)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to update the security settings of org %q", org), err.Error())
+		return
+	}
+
+	organizationSecuritySettingsToData(org, o, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSecuritySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationSecuritySettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+
+	o, _, err := r.provider.Client.GetOrganization(org)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read the security settings of org %q", org), err.Error())
+		return
+	}
+
+	organizationSecuritySettingsToData(org, o, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSecuritySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data organizationSecuritySettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	o, err := updateOrganizationSecuritySettings(r.provider, org, &data)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to update the security settings of org %q", org), err.Error())
+		return
+	}
+
+	organizationSecuritySettingsToData(org, o, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete has no API counterpart: the settings are part of the organization,
+// they are left unchanged so that removing the resource never weakens them.
+func (r *organizationSecuritySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState supports: terraform import cycloid_organization_security_settings.x <organization>
+func (r *organizationSecuritySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data organizationSecuritySettingsResourceModel
+	data.Organization = types.StringValue(req.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateOrganizationSecuritySettings updates the organization with the
+// settings of data, unset ones keep their current value.
+func updateOrganizationSecuritySettings(p *CycloidProvider, org string, data *organizationSecuritySettingsResourceModel) (*models.Organization, error) {
+	return updateOrganization(p, org, func(body map[string]interface{}) {
+		if !data.MfaEnabled.IsNull() && !data.MfaEnabled.IsUnknown() {
+			body["mfa_enabled"] = data.MfaEnabled.ValueBool()
+		}
+		if !data.SsoInviteOnly.IsNull() && !data.SsoInviteOnly.IsUnknown() {
+			body["sso_invite_only"] = data.SsoInviteOnly.ValueBool()
+		}
+		if !data.CreationRestrictedMessage.IsNull() && !data.CreationRestrictedMessage.IsUnknown() {
+			body["creation_restricted_message"] = data.CreationRestrictedMessage.ValueString()
+		}
+	})
+}
+
+func organizationSecuritySettingsToData(org string, o *models.Organization, data *organizationSecuritySettingsResourceModel) {
+	data.Organization = types.StringValue(org)
+	data.MfaEnabled = types.BoolValue(ptr.Value(o.MfaEnabled))
+	data.SsoInviteOnly = types.BoolValue(ptr.Value(o.SsoInviteOnly))
+	// creation_restricted_message is not returned, data keeps the one sent
+}
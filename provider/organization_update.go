@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+)
+
+// updateOrganization updates the organization 'org' with the fields 'set'
+// changes in the body. apiclient.UpdateOrganization only sends the name and
+// can_children_manage_oidc_mapping, and the PUT replaces the organization, so
+// the body starts from the current values of the organization. It is sent as
+// a map because the models.UpdateOrganization booleans are omitted when
+// false.
+//
+// The resources managing different fields of the same organization, such as
+// cycloid_organization and cycloid_organization_security_settings, would
+// overwrite each other between the read and the PUT, the whole update is then
+// done under the OrganizationUpdates lock of the provider.
+//
+// creation_restricted_message is not returned by GetOrganization, so it can
+// only be sent when 'set' sets it; otherwise it is omitted and left to the
+// API. The naming rules returned by getOrganizationNamingRules are sent back
+// as they are, so that the other updates do not clear them.
+func updateOrganization(p *CycloidProvider, org string, set func(body map[string]interface{})) (*models.Organization, error) {
+	p.OrganizationUpdates.Lock()
+	defer p.OrganizationUpdates.Unlock()
+
+	m := p.Client
+	o, _, err := m.GetOrganization(org)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := getOrganizationNamingRules(m, org)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":                             ptr.Value(o.Name),
+		"mfa_enabled":                      ptr.Value(o.MfaEnabled),
+		"sso_invite_only":                  ptr.Value(o.SsoInviteOnly),
+		"can_children_create_appearance":   ptr.Value(o.CanChildrenCreateAppearance),
+		"can_children_manage_oidc_mapping": ptr.Value(o.CanChildrenManageOidcMapping),
+		"can_children_use_dedicated_authentication": ptr.Value(o.CanChildrenUseDedicatedAuthentication),
+		"is_using_dedicated_authentication":         ptr.Value(o.IsUsingDedicatedAuthentication),
+		"stack_branch_include_patterns":             o.StackBranchIncludePatterns,
+		"stack_branch_exclude_patterns":             o.StackBranchExcludePatterns,
+	}
+	for field, value := range rules.fields() {
+		if value != nil {
+			body[field] = *value
+		}
+	}
+	set(body)
+
+	var result *models.Organization
+	_, err = m.GenericRequest(apiclient.Request{
+		Method:       "PUT",
+		Organization: &org,
+		Route:        []string{"organizations", org},
+		Body:         body,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result, _, err = m.GetOrganization(org)
+	}
+	return result, err
+}
+
+// organizationNamingRules are the naming rules fields of the organization,
+// those of models.UpdateOrganizationNamingRules. models.Organization and
+// models.UpdateOrganization do not have them and the vendored apiclient has
+// no route for them, so they are read from and sent in the organization body:
+// this is not checked against the API spec, move it to apiclient once
+// cycloid-cli has it. The fields are pointers to tell the rules not returned
+// by the API from the cleared ones.
+type organizationNamingRules struct {
+	ProjectRegex     *string `json:"project_regex"`
+	ProjectMessage   *string `json:"project_message"`
+	EnvRegex         *string `json:"env_regex"`
+	EnvMessage       *string `json:"env_message"`
+	ComponentRegex   *string `json:"component_regex"`
+	ComponentMessage *string `json:"component_message"`
+}
+
+// fields returns the rules by body field.
+func (r *organizationNamingRules) fields() map[string]*string {
+	return map[string]*string{
+		"project_regex":     r.ProjectRegex,
+		"project_message":   r.ProjectMessage,
+		"env_regex":         r.EnvRegex,
+		"env_message":       r.EnvMessage,
+		"component_regex":   r.ComponentRegex,
+		"component_message": r.ComponentMessage,
+	}
+}
+
+// returned reports whether the API returned any of the rules.
+func (r *organizationNamingRules) returned() bool {
+	for _, value := range r.fields() {
+		if value != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// getOrganizationNamingRules reads the naming rules of the organization 'org'.
+func getOrganizationNamingRules(m apiclient.APIClient, org string) (*organizationNamingRules, error) {
+	var rules organizationNamingRules
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org},
+	}, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
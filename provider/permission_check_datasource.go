@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_permission_check"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/policy"
+)
+
+var _ datasource.DataSource = (*permissionCheckDataSource)(nil)
+
+type permissionCheckDataSource struct {
+	provider *CycloidProvider
+}
+
+type permissionCheckDatasourceModel = datasource_permission_check.PermissionCheckModel
+
+func NewPermissionCheckDataSource() datasource.DataSource {
+	return &permissionCheckDataSource{}
+}
+
+func (s *permissionCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_check"
+}
+
+func (s *permissionCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_permission_check.PermissionCheckDataSourceSchema(ctx)
+}
+
+func (s *permissionCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *permissionCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data permissionCheckDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+	m := s.provider.Client
+
+	var checks []datasource_permission_check.CheckModel
+	resp.Diagnostics.Append(data.Checks.ElementsAs(ctx, &checks, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputs := make([]*models.CanDoBatchActionInput, 0, len(checks))
+	for i, c := range checks {
+		canonicals := []string{}
+		if !c.EntityCanonicals.IsNull() {
+			resp.Diagnostics.Append(c.EntityCanonicals.ElementsAs(ctx, &canonicals, false)...)
+		}
+		if len(canonicals) > 0 && policy.Entity(c.Action.ValueString()) == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("checks").AtListIndex(i).AtName("entity_canonicals"),
+				"Unexpected entity canonicals",
+				fmt.Sprintf("The action %q applies to the organization itself, remove entity_canonicals.", c.Action.ValueString()),
+			)
+		}
+		inputs = append(inputs, &models.CanDoBatchActionInput{
+			Action:           c.Action.ValueStringPointer(),
+			Canonical:        ptr.Ptr(strconv.Itoa(i)),
+			EntityCanonicals: canonicals,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		allowed []bool
+		err     error
+	)
+	if data.MemberID.IsNull() {
+		allowed, err = canDoBatch(m, org, inputs)
+	} else {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("member_id"),
+			"Approximate permission check",
+			"The API cannot check the actions of another member, the results are evaluated by the provider from the allow rules of their roles and may differ from what the API enforces.",
+		)
+		allowed, err = memberCanDo(m, org, uint32(data.MemberID.ValueInt64()), inputs)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to check the permissions", err.Error())
+		return
+	}
+
+	results := make([]attr.Value, 0, len(inputs))
+	denied := []string{}
+	for i, in := range inputs {
+		canonicals, d := types.ListValueFrom(ctx, types.StringType, in.EntityCanonicals)
+		resp.Diagnostics.Append(d...)
+		v, d := types.ObjectValue(datasource_permission_check.ResultAttrTypes(), map[string]attr.Value{
+			"action":            types.StringPointerValue(in.Action),
+			"entity_canonicals": canonicals,
+			"allowed":           types.BoolValue(allowed[i]),
+		})
+		resp.Diagnostics.Append(d...)
+		results = append(results, v)
+		if !allowed[i] {
+			denied = append(denied, ptr.Value(in.Action))
+		}
+	}
+
+	var d diag.Diagnostics
+	data.Organization = types.StringValue(org)
+	data.AllAllowed = types.BoolValue(len(denied) == 0)
+	data.Results, d = types.ListValue(types.ObjectType{AttrTypes: datasource_permission_check.ResultAttrTypes()}, results)
+	resp.Diagnostics.Append(d...)
+	data.DeniedActions, d = types.ListValueFrom(ctx, types.StringType, denied)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// canDoBatch checks the 'inputs' for the API key of the provider, the
+// results are in the order of 'inputs'.
+//
+// models.CanDoBatchInput is documented as the input of the 'can_do_batch'
+// endpoint, but the vendored apiclient has no function for it, so the route
+// is not checked against the API spec: move it to apiclient once cycloid-cli
+// has it.
+func canDoBatch(m apiclient.APIClient, org string, inputs []*models.CanDoBatchActionInput) ([]bool, error) {
+	var result map[string]models.CanDoBatchOutputItem
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "POST",
+		Organization: &org,
+		Route:        []string{"organizations", org, "can_do", "batch"},
+		Body:         &models.CanDoBatchInput{Actions: inputs},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]bool, len(inputs))
+	for i, in := range inputs {
+		item, ok := result[ptr.Value(in.Canonical)]
+		if !ok {
+			return nil, fmt.Errorf("the API returned no result for the action %q", ptr.Value(in.Action))
+		}
+		allowed[i] = ptr.Value(item.Ok)
+	}
+	return allowed, nil
+}
+
+// memberCanDo evaluates the 'inputs' against the rules of the role of the
+// member 'id' and of the roles of their teams, the results are in the order
+// of 'inputs'.
+func memberCanDo(m apiclient.APIClient, org string, id uint32, inputs []*models.CanDoBatchActionInput) ([]bool, error) {
+	member, _, err := m.GetMember(org, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the member %d: %w", id, err)
+	}
+
+	var roles []string
+	if member.Role != nil {
+		roles = append(roles, ptr.Value(member.Role.Canonical))
+	}
+	for _, st := range member.Teams {
+		team, _, err := m.GetTeam(org, ptr.Value(st.Canonical))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the team %q of the member %d: %w", ptr.Value(st.Canonical), id, err)
+		}
+		for _, r := range team.Roles {
+			roles = append(roles, ptr.Value(r.Canonical))
+		}
+	}
+
+	var rules []policy.Rule
+	seen := make(map[string]bool)
+	for _, canonical := range roles {
+		if canonical == "" || seen[canonical] {
+			continue
+		}
+		seen[canonical] = true
+
+		role, _, err := m.GetRole(org, canonical)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the role %q: %w", canonical, err)
+		}
+		for _, r := range role.Rules {
+			if ptr.Value(r.Effect) != policy.EffectAllow {
+				continue
+			}
+			rules = append(rules, policy.Rule{Action: ptr.Value(r.Action), Resources: r.Resources})
+		}
+	}
+
+	allowed := make([]bool, len(inputs))
+	for i, in := range inputs {
+		action := ptr.Value(in.Action)
+		allowed[i] = policy.Allows(rules, action, policy.Resource(org, action, in.EntityCanonicals))
+	}
+	return allowed, nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_policy_document"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/policy"
+)
+
+var _ datasource.DataSource = (*policyDocumentDataSource)(nil)
+
+// policyDocumentDataSource does not call the API, the rules are composed
+// from the configuration only.
+type policyDocumentDataSource struct{}
+
+type policyDocumentDatasourceModel = datasource_policy_document.PolicyDocumentModel
+
+func NewPolicyDocumentDataSource() datasource.DataSource {
+	return &policyDocumentDataSource{}
+}
+
+func (s *policyDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_document"
+}
+
+func (s *policyDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_policy_document.PolicyDocumentDataSourceSchema(ctx)
+}
+
+func (s *policyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data policyDocumentDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []datasource_policy_document.RuleModel
+	if !data.SourceRules.IsNull() {
+		resp.Diagnostics.Append(data.SourceRules.ElementsAs(ctx, &rules, false)...)
+	}
+
+	var statements []datasource_policy_document.StatementModel
+	if !data.Statements.IsNull() {
+		resp.Diagnostics.Append(data.Statements.ElementsAs(ctx, &statements, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Statements are turned into one rule by action
+	for _, st := range statements {
+		var actions []string
+		resp.Diagnostics.Append(st.Actions.ElementsAs(ctx, &actions, false)...)
+		for _, a := range actions {
+			rules = append(rules, datasource_policy_document.RuleModel{
+				Action:    types.StringValue(a),
+				Effect:    st.Effect,
+				Resources: st.Resources,
+			})
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resources by action, nil for a global rule
+	merged := make(map[string][]string)
+	for _, r := range rules {
+		action := r.Action.ValueString()
+
+		if effect := r.Effect.ValueString(); effect != "" && effect != policy.EffectAllow {
+			resp.Diagnostics.AddError("Unsupported effect", fmt.Sprintf("The rule of the action %q has the effect %q, only %q is supported.", action, effect, policy.EffectAllow))
+			continue
+		}
+
+		if !data.AllowUnknownActions.ValueBool() {
+			if err := policy.ValidateAction(action); err != nil {
+				resp.Diagnostics.AddError(
+					"Unknown action",
+					fmt.Sprintf("%s, check its spelling or set allow_unknown_actions if it is newer than the provider.", err),
+				)
+				continue
+			}
+		}
+
+		var resources []string
+		if !r.Resources.IsNull() {
+			resp.Diagnostics.Append(r.Resources.ElementsAs(ctx, &resources, false)...)
+		}
+		for _, res := range resources {
+			if err := policy.ValidateResource(res); err != nil {
+				resp.Diagnostics.AddError("Invalid resource", err.Error())
+			}
+		}
+
+		actions := []string{action}
+		if data.ExpandWildcards.ValueBool() && policy.IsGlob(action) {
+			actions = policy.Expand(action)
+		}
+		for _, a := range actions {
+			prev, ok := merged[a]
+			switch {
+			case ok && prev == nil, len(resources) == 0:
+				// A global rule covers any resource
+				merged[a] = nil
+			default:
+				merged[a] = append(prev, resources...)
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions := slices.Sorted(maps.Keys(merged))
+	values := make([]attr.Value, 0, len(actions))
+	for _, a := range actions {
+		resources := merged[a]
+		slices.Sort(resources)
+		resources = slices.Compact(resources)
+		if resources == nil {
+			resources = []string{}
+		}
+
+		l, d := types.ListValueFrom(ctx, types.StringType, resources)
+		resp.Diagnostics.Append(d...)
+		v, d := types.ObjectValue(datasource_policy_document.RuleAttrTypes(), map[string]attr.Value{
+			"action":    types.StringValue(a),
+			"effect":    types.StringValue(policy.EffectAllow),
+			"resources": l,
+		})
+		resp.Diagnostics.Append(d...)
+		values = append(values, v)
+	}
+
+	var d diag.Diagnostics
+	data.Rules, d = types.ListValue(types.ObjectType{AttrTypes: datasource_policy_document.RuleAttrTypes()}, values)
+	resp.Diagnostics.Append(d...)
+	data.Actions, d = types.ListValueFrom(ctx, types.StringType, actions)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"os"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,10 +18,13 @@ import (
 )
 
 var _ provider.Provider = (*CycloidProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*CycloidProvider)(nil)
 
 func New() func() provider.Provider {
 	return func() provider.Provider {
-		return &CycloidProvider{}
+		return &CycloidProvider{
+			OrganizationUpdates: &sync.Mutex{},
+		}
 	}
 }
 
@@ -31,6 +36,10 @@ type CycloidProvider struct {
 	Insecure            bool
 	APIClient           *common.APIClient
 	Client              apiclient.APIClient
+
+	// OrganizationUpdates serializes the updates of the organizations, see
+	// updateOrganization.
+	OrganizationUpdates *sync.Mutex
 }
 
 func (p *CycloidProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -119,13 +128,16 @@ func (p *CycloidProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	resp.ResourceData = p
 	resp.DataSourceData = p
+	resp.EphemeralResourceData = p
 }
 
 func (p *CycloidProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewStacksDataSource,
+		NewStackFormsDataSource,
 		NewCredentialsDataSource,
 		NewCredentialDataSource,
+		NewCredentialUsageDataSource,
 		NewInventoryValueDataSource,
 		NewInventoryValuesDataSource,
 		NewTerraformOutputDataSource,
@@ -138,10 +150,24 @@ func (p *CycloidProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewPluginWidgetsDataSource,
 		NewPluginWidgetViewsDataSource,
 		NewOrganizationMembersDataSource,
+		NewPolicyDocumentDataSource,
+		NewPermissionCheckDataSource,
+		NewTeamsDataSource,
+		NewTeamDataSource,
+		NewTeamMembersDataSource,
+		NewOrganizationRolesDataSource,
+		NewOrganizationRoleDataSource,
+		NewOrganizationInvitationsDataSource,
+		NewOIDCGroupMappingsDataSource,
 		NewEnvironmentTypeDataSource,
 		NewEnvironmentTypesDataSource,
 		NewCloudAccountDataSource,
 		NewCloudAccountsDataSource,
+		NewCloudCostDashboardDataSource,
+		NewCloudCostProjectsDashboardDataSource,
+		NewExternalBackendDataSource,
+		NewExternalBackendsDataSource,
+		NewRemoteTFStateBackendDataSource,
 		NewEnvironmentDataSource,
 		NewEnvironmentsDataSource,
 	}
@@ -151,7 +177,9 @@ func (p *CycloidProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewOrganizationResource,
 		NewCredentialResource,
+		NewCredentialRotationResource,
 		NewCatalogRepositoryResource,
+		NewCatalogRepositoryStacksPolicyResource,
 		NewConfigRepositoryResource,
 		NewExternalBackendResource,
 		NewOrganizationMemberResource,
@@ -161,6 +189,7 @@ func (p *CycloidProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewEnvironmentResource,
 		NewTeamResource,
 		NewTeamMemberResource,
+		NewTeamMembersResource,
 		NewComponentResource,
 		NewPluginRegistryResource,
 		NewPluginManagerResource,
@@ -169,11 +198,17 @@ func (p *CycloidProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewPluginResource,
 		NewEnvironmentTypeResource,
 		NewCloudAccountResource,
+		NewCloudCostManagementAccountResource,
+		NewCloudCostTagMappingResource,
 		NewEnvironmentLinkResource,
 		NewOrganizationEnvironmentResource,
 		NewOIDCGroupMappingResource,
+		NewOIDCGroupMappingsResource,
 		NewOIDCOrganizationSettingsResource,
 		NewOIDCIntegrationResource,
+		NewOrganizationAuthenticationResource,
+		NewOrganizationSecuritySettingsResource,
+		NewOrganizationNamingRulesResource,
 		NewOrganizationAPIKeyResource,
 		NewOrganizationNavOrderResource,
 		NewPluginSharingResource,
@@ -181,4 +216,11 @@ func (p *CycloidProvider) Resources(ctx context.Context) []func() resource.Resou
 	}
 }
 
+func (p *CycloidProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCredentialEphemeralResource,
+		NewSessionTokenEphemeralResource,
+	}
+}
+
 func (p *CycloidProvider) GetResourceIdentitySchema() {}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_external_backends"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_remote_tf_state_backend"
+)
+
+var _ datasource.DataSource = (*remoteTFStateBackendDataSource)(nil)
+
+type remoteTFStateBackendDataSource struct {
+	provider *CycloidProvider
+}
+
+type remoteTFStateBackendDatasourceModel = datasource_remote_tf_state_backend.RemoteTfStateBackendModel
+
+func NewRemoteTFStateBackendDataSource() datasource.DataSource {
+	return &remoteTFStateBackendDataSource{}
+}
+
+func (s *remoteTFStateBackendDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_tf_state_backend"
+}
+
+func (s *remoteTFStateBackendDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_remote_tf_state_backend.RemoteTfStateBackendDataSourceSchema(ctx)
+}
+
+func (s *remoteTFStateBackendDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *remoteTFStateBackendDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data remoteTFStateBackendDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+
+	ebs, _, err := s.provider.Client.ListExternalBackends(org)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list external backends", err.Error())
+		return
+	}
+
+	eb, scope := resolveRemoteTFStateBackend(ebs, data.ProjectCanonical.ValueString(), data.EnvironmentCanonical.ValueString(), data.ComponentCanonical.ValueString())
+	if eb == nil {
+		resp.Diagnostics.AddError(
+			"remote Terraform state backend not found",
+			fmt.Sprintf("The organization %q has no remote_tfstate external backend matching the project, environment and component, nor a default one.", org),
+		)
+		return
+	}
+
+	item, diags := externalBackendToItem(eb)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendType, backendConfig, err := terraformBackendConfig(eb.Configuration())
+	if err != nil {
+		resp.Diagnostics.AddError("unsupported remote Terraform state backend", err.Error())
+		return
+	}
+
+	data.Organization = types.StringValue(org)
+	data.Scope = types.StringValue(scope)
+	data.BackendType = types.StringValue(backendType)
+	data.ExternalBackend, diags = types.ObjectValueFrom(ctx, datasource_external_backends.ExternalBackendAttrTypes(), item)
+	resp.Diagnostics.Append(diags...)
+	data.BackendConfig, diags = types.MapValueFrom(ctx, types.StringType, backendConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveRemoteTFStateBackend returns the most specific remote_tfstate
+// backend of 'ebs' for the project, environment and component, along with
+// what it is scoped to. The default backend of the organization is the
+// fallback.
+func resolveRemoteTFStateBackend(ebs []*models.ExternalBackend, project, env, component string) (*models.ExternalBackend, string) {
+	var (
+		best      *models.ExternalBackend
+		bestScore = -1
+		scopes    = []string{"organization", "project", "environment", "component"}
+	)
+
+	for _, eb := range ebs {
+		if ptr.Value(eb.Purpose) != "remote_tfstate" {
+			continue
+		}
+
+		// An external backend applies when every canonical it is scoped to
+		// matches, the more canonicals the more specific
+		score := 0
+		matches := true
+		for _, c := range []struct{ scoped, requested string }{
+			{eb.ProjectCanonical, project},
+			{eb.EnvironmentCanonical, env},
+			{eb.ComponentCanonical, component},
+		} {
+			if c.scoped == "" {
+				break
+			}
+			if c.scoped != c.requested {
+				matches = false
+				break
+			}
+			score++
+		}
+		if !matches || (score == 0 && !ptr.Value(eb.Default)) {
+			continue
+		}
+
+		if score > bestScore {
+			best, bestScore = eb, score
+		}
+	}
+
+	if best == nil {
+		return nil, ""
+	}
+	return best, scopes[bestScore]
+}
+
+// terraformBackendConfig returns the Terraform backend type and
+// configuration matching the 'cfg', without the credentials.
+func terraformBackendConfig(cfg models.ExternalBackendConfiguration) (string, map[string]string, error) {
+	conf := make(map[string]string)
+	set := func(k, v string) {
+		if v != "" {
+			conf[k] = v
+		}
+	}
+
+	switch c := cfg.(type) {
+	case *models.AWSRemoteTFState:
+		set("bucket", ptr.Value(c.Bucket))
+		set("key", c.Key)
+		set("region", ptr.Value(c.Region))
+		set("endpoint", c.Endpoint)
+		if c.S3ForcePathStyle {
+			set("use_path_style", "true")
+		}
+		if c.SkipVerifySsl {
+			set("insecure", "true")
+		}
+		return "s3", conf, nil
+	case *models.AWSStorage:
+		return terraformBackendConfig(&models.AWSRemoteTFState{
+			Bucket: c.Bucket, Key: c.Key, Region: c.Region, Endpoint: c.Endpoint,
+			S3ForcePathStyle: c.S3ForcePathStyle, SkipVerifySsl: c.SkipVerifySsl,
+		})
+	case *models.GCPRemoteTFState:
+		set("bucket", ptr.Value(c.Bucket))
+		set("prefix", c.Object)
+		return "gcs", conf, nil
+	case *models.GCPStorage:
+		return terraformBackendConfig(&models.GCPRemoteTFState{Bucket: c.Bucket, Object: c.Object})
+	case *models.AzureRemoteTFState:
+		set("container_name", ptr.Value(c.Container))
+		set("key", c.Blob)
+		set("endpoint", c.Endpoint)
+		return "azurerm", conf, nil
+	case *models.AzureStorage:
+		return terraformBackendConfig(&models.AzureRemoteTFState{Container: c.Container, Blob: c.Blob, Endpoint: c.Endpoint})
+	case *models.SwiftRemoteTFState:
+		set("container", ptr.Value(c.Container))
+		set("region_name", ptr.Value(c.Region))
+		set("state_name", c.Object)
+		if c.SkipVerifySsl {
+			set("insecure", "true")
+		}
+		return "swift", conf, nil
+	case *models.SwiftStorage:
+		return terraformBackendConfig(&models.SwiftRemoteTFState{Container: c.Container, Region: c.Region, Object: c.Object, SkipVerifySsl: c.SkipVerifySsl})
+	case *models.HTTPStorage:
+		set("address", ptr.Value(c.URL))
+		return "http", conf, nil
+	case *models.GitLabHTTPStorage:
+		set("address", ptr.Value(c.URL))
+		set("lock_address", ptr.Value(c.URL)+"/lock")
+		set("unlock_address", ptr.Value(c.URL)+"/lock")
+		set("lock_method", "POST")
+		set("unlock_method", "DELETE")
+		return "http", conf, nil
+	case nil:
+		return "", nil, fmt.Errorf("the external backend has no configuration")
+	}
+
+	return "", nil, fmt.Errorf("the engine %q has no matching Terraform backend", cfg.Engine())
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+)
+
+// repositoryConnection is the subset of a config or catalog repository
+// needed to test that the backend can reach it.
+type repositoryConnection struct {
+	URL        types.String
+	Branch     types.String
+	Credential types.String
+}
+
+// changed reports whether the connection settings differ from old, so the
+// connection is only tested again when something that affects it changed.
+func (c repositoryConnection) changed(old repositoryConnection) bool {
+	return !c.URL.Equal(old.URL) || !c.Branch.Equal(old.Branch) || !c.Credential.Equal(old.Credential)
+}
+
+// known reports whether every connection setting is known. Unknown values are
+// only resolved at apply, where the plan is computed again and checked then.
+func (c repositoryConnection) known() bool {
+	return !c.URL.IsUnknown() && !c.Branch.IsUnknown() && !c.Credential.IsUnknown()
+}
+
+// listGitBranches lists the branches of the git repository at gitURL, as seen
+// by the Cycloid backend when using the credential.
+//
+// The vendored apiclient has no function for this route, so it is not
+// checked against the API spec: move it to apiclient once cycloid-cli has it.
+func listGitBranches(m apiclient.APIClient, org, gitURL, credential string) ([]string, error) {
+	query := url.Values{"git_url": []string{gitURL}}
+	if credential != "" {
+		query.Set("credential_canonical", credential)
+	}
+
+	var branches []string
+	_, err := m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org, "branches"},
+		Query:        query,
+	}, &branches)
+	if err != nil {
+		return nil, err
+	}
+
+	return branches, nil
+}
+
+// validateRepositoryConnection checks that the backend can list the branches
+// of the repository with its credential and that the configured branch exists.
+func validateRepositoryConnection(m apiclient.APIClient, org string, conn repositoryConnection) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	gitURL := conn.URL.ValueString()
+	branch := conn.Branch.ValueString()
+	credential := conn.Credential.ValueString()
+
+	branches, err := listGitBranches(m, org, gitURL, credential)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("url"),
+			"Unable to reach git repository",
+			fmt.Sprintf("Cycloid failed to list the branches of %q using credential %q: %s. Check that the URL is correct and that the credential has read access to the repository.", gitURL, credential, err.Error()),
+		)
+		return diags
+	}
+
+	if branch != "" && !slices.Contains(branches, branch) {
+		diags.AddAttributeError(
+			path.Root("branch"),
+			"Branch not found in git repository",
+			fmt.Sprintf("Branch %q does not exist in %q. Available branches: %v.", branch, gitURL, branches),
+		)
+	}
+
+	return diags
+}
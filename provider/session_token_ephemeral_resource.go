@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/ephemeral_session_token"
+)
+
+var _ ephemeral.EphemeralResource = (*sessionTokenEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*sessionTokenEphemeralResource)(nil)
+
+func NewSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &sessionTokenEphemeralResource{}
+}
+
+type sessionTokenEphemeralResource struct {
+	provider *CycloidProvider
+}
+
+type sessionTokenEphemeralResourceModel = ephemeral_session_token.SessionTokenModel
+
+func (r *sessionTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_token"
+}
+
+func (r *sessionTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ephemeral_session_token.SessionTokenEphemeralResourceSchema(ctx)
+}
+
+func (r *sessionTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+func (r *sessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data sessionTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := r.provider.Client
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	child := data.ChildOrganization.ValueStringPointer()
+
+	var (
+		session *models.UserSession
+		err     error
+	)
+	if !data.Email.IsNull() {
+		session, _, err = m.UserLoginToOrg(org, data.Email.ValueString(), data.Password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to log in to the organization %q", org), err.Error())
+			return
+		}
+		// The login cannot be scoped to a child organization, its token is
+		// refreshed for it
+		if child != nil {
+			session, _, err = m.RefreshToken(&org, child, ptr.Value(session.Token))
+		}
+	} else {
+		session, _, err = m.RefreshToken(&org, child, r.provider.APIKey)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to get a session token for the organization %q", Coalesce(ptr.Value(child), org)), err.Error())
+		return
+	}
+	if session == nil || ptr.Value(session.Token) == "" {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to get a session token for the organization %q", Coalesce(ptr.Value(child), org)), "empty response from the API")
+		return
+	}
+
+	token := ptr.Value(session.Token)
+	data.Organization = types.StringValue(org)
+	data.Token = types.StringValue(token)
+	data.ExpiresAt = types.StringNull()
+	if exp, ok := jwtExpiresAt(token); ok {
+		data.ExpiresAt = types.StringValue(exp.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// jwtExpiresAt returns the expiration time of the 'token', without verifying
+// it.
+func jwtExpiresAt(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Exp, 0), true
+}
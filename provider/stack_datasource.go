@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -10,9 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
 	"github.com/cycloidio/cycloid-cli/gen/models"
-	"github.com/cycloidio/terraform-provider-cycloid/datasource_stacks"
 	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_stacks"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
 )
 
 var _ datasource.DataSource = &stackDataSource{}
@@ -67,13 +70,34 @@ func (s *stackDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	mid := s.provider.Client
 
+	lhsFilters, diags := lhsfilter.FromList(ctx, data.Filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Visibility.IsNull() && !data.Visibility.IsUnknown() {
+		lhsFilters = append(lhsFilters, apiclient.LHSFilter{Attribute: "service_catalog_visibility", Condition: "eq", Value: data.Visibility.ValueString()})
+	}
+
+	var keywords, technologies, cloudProviders []string
+	resp.Diagnostics.Append(stringListElements(ctx, data.Keywords, &keywords)...)
+	resp.Diagnostics.Append(stringListElements(ctx, data.Technologies, &technologies)...)
+	resp.Diagnostics.Append(stringListElements(ctx, data.CloudProviders, &cloudProviders)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	org := getOrganizationCanonical(*s.provider, data.OrganizationCanonical)
-	stacks, _, err := mid.ListStacks(org)
+	stacks, _, err := mid.ListStacks(org, lhsFilters...)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to list stacks from api", err.Error())
 		return
 	}
 
+	stacks = slices.DeleteFunc(stacks, func(stack *models.ServiceCatalog) bool {
+		return stack == nil || !stackMatches(stack, keywords, technologies, cloudProviders, data.Team)
+	})
+
 	stacksValues, errDiags := dataStacksToListValue(ctx, stacks)
 	if errDiags.HasError() {
 		resp.Diagnostics.Append(errDiags...)
@@ -86,6 +110,52 @@ func (s *stackDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// stringListElements decodes an optional list of strings, leaving dst empty
+// when the list is not set.
+func stringListElements(ctx context.Context, list types.List, dst *[]string) diag.Diagnostics {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	return list.ElementsAs(ctx, dst, false)
+}
+
+// stackMatches reports whether the stack matches the convenience arguments of
+// the data source. Each non empty list matches when the stack has at least
+// one of its values. The visibility is sent to the API as a LHS filter, but
+// apiclient.ListStacks documents no LHS filter attribute for the keywords,
+// technologies, cloud providers and team, so they are matched here.
+func stackMatches(stack *models.ServiceCatalog, keywords, technologies, cloudProviders []string, team types.String) bool {
+	if len(keywords) > 0 && !slices.ContainsFunc(stack.Keywords, func(k string) bool {
+		return slices.Contains(keywords, k)
+	}) {
+		return false
+	}
+
+	if len(technologies) > 0 && !slices.ContainsFunc(stack.Technologies, func(t *models.ServiceCatalogTechnology) bool {
+		return t != nil && slices.Contains(technologies, t.Technology)
+	}) {
+		return false
+	}
+
+	if len(cloudProviders) > 0 && !slices.ContainsFunc(stack.CloudProviders, func(cp *models.CloudProvider) bool {
+		return cp != nil && slices.Contains(cloudProviders, ptr.Value(cp.Canonical))
+	}) {
+		return false
+	}
+
+	if !team.IsNull() {
+		teamCan := ""
+		if stack.Team != nil {
+			teamCan = ptr.Value(stack.Team.Canonical)
+		}
+		if teamCan != team.ValueString() {
+			return false
+		}
+	}
+
+	return true
+}
+
 func dataStacksToListValue(ctx context.Context, stacks []*models.ServiceCatalog) (basetypes.ListValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_stack_forms"
+)
+
+var _ datasource.DataSource = &stackFormsDataSource{}
+
+type stackFormsDatasourceModel = datasource_stack_forms.StackFormsModel
+
+type stackFormsDataSource struct {
+	provider *CycloidProvider
+}
+
+func NewStackFormsDataSource() datasource.DataSource {
+	return &stackFormsDataSource{}
+}
+
+func (s *stackFormsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_forms"
+}
+
+func (s *stackFormsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_stack_forms.StackFormsDataSourceSchema(ctx)
+}
+
+func (s *stackFormsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+	s.provider = pv
+}
+
+func (s *stackFormsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data stackFormsDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := s.provider.Client
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+	stackRef := data.StackRef.ValueString()
+
+	stack, _, err := m.GetStack(org, stackRef)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("stack_ref"),
+			fmt.Sprintf("failed to get stack %q in org %q", stackRef, org),
+			err.Error(),
+		)
+		return
+	}
+
+	versionID, commitHash, err := resolveStackVersionID(m, org, stackRef, data.StackVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("stack_version"),
+			fmt.Sprintf("failed to resolve version of stack %q in org %q", stackRef, org),
+			err.Error(),
+		)
+		return
+	}
+
+	// The vendored apiclient has no function for this route: it is the
+	// sibling of the use_cases one of apiclient.ListStackUseCases, with the
+	// same service_catalog_source_version_id query, and answers the
+	// models.ServiceCatalogConfigs of the use cases. It is not checked
+	// against the API spec, move it to apiclient once cycloid-cli has it.
+	var configs models.ServiceCatalogConfigs
+	_, err = m.GenericRequest(apiclient.Request{
+		Method:       "GET",
+		Organization: &org,
+		Route:        []string{"organizations", org, "service_catalogs", stackRef, "config"},
+		Query: url.Values{
+			"service_catalog_source_version_id": []string{strconv.FormatUint(uint64(versionID), 10)},
+		},
+	}, &configs)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to get the forms of stack %q in org %q", stackRef, org), err.Error())
+		return
+	}
+
+	useCase := data.UseCase.ValueString()
+	if useCase != "" {
+		config, ok := configs[useCase]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("use_case"),
+				"Use case not found",
+				fmt.Sprintf("stack %q has no use case %q at version %q", stackRef, useCase, commitHash),
+			)
+			return
+		}
+		configs = models.ServiceCatalogConfigs{useCase: config}
+	}
+
+	technologies := make([]datasource_stack_forms.TechnologyItem, 0, len(stack.Technologies))
+	for _, t := range stack.Technologies {
+		technologies = append(technologies, datasource_stack_forms.TechnologyItem{
+			Technology: types.StringValue(t.Technology),
+			Version:    types.StringValue(t.Version),
+		})
+	}
+
+	useCases := make([]datasource_stack_forms.UseCaseItem, 0, len(configs))
+	for key, config := range configs {
+		useCases = append(useCases, stackFormsUseCaseToItem(key, config))
+	}
+	slices.SortFunc(useCases, func(a, b datasource_stack_forms.UseCaseItem) int {
+		return cmp.Compare(a.UseCase.ValueString(), b.UseCase.ValueString())
+	})
+
+	var diags diag.Diagnostics
+	data.Technologies, diags = types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: datasource_stack_forms.TechnologyAttrTypes(),
+	}, technologies)
+	resp.Diagnostics.Append(diags...)
+
+	data.UseCases, diags = types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: datasource_stack_forms.UseCaseAttrTypes(),
+	}, useCases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Organization = types.StringValue(org)
+	data.CommitHash = types.StringValue(commitHash)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveStackVersionID returns the version ID and commit hash matching
+// stackVersion, looked up as a tag, then a branch, then a commit hash.
+// An empty stackVersion resolves to the stack's default version.
+func resolveStackVersionID(m apiclient.APIClient, org, stackRef, stackVersion string) (uint32, string, error) {
+	if stackVersion == "" {
+		return m.ResolveStackVersion(org, stackRef, "")
+	}
+
+	versions, _, err := m.ListStackVersions(org, stackRef)
+	if err != nil {
+		return 0, "", err
+	}
+
+	tag, branch, commit := matchStackVersion(versions, &stackVersion)
+	for _, v := range versions {
+		if v == nil || v.ID == nil {
+			continue
+		}
+
+		name := ptr.Value(v.Name)
+		switch {
+		case tag != "" && ptr.Value(v.Type) == "tag" && name == tag,
+			tag == "" && branch != "" && ptr.Value(v.Type) == "branch" && name == branch,
+			tag == "" && branch == "" && commit != "" && ptr.Value(v.CommitHash) == commit:
+			return *v.ID, ptr.Value(v.CommitHash), nil
+		}
+	}
+
+	return 0, "", fmt.Errorf("no tag, branch or commit matching %q", stackVersion)
+}
+
+func stackFormsUseCaseToItem(key string, config models.ServiceCatalogConfig) datasource_stack_forms.UseCaseItem {
+	item := datasource_stack_forms.UseCaseItem{
+		UseCase:       types.StringValue(key),
+		Name:          types.StringPointerValue(config.Name),
+		Description:   types.StringPointerValue(config.Description),
+		CloudProvider: types.StringPointerValue(config.CloudProvider),
+		Sections:      []datasource_stack_forms.SectionItem{},
+	}
+
+	if config.Forms == nil {
+		return item
+	}
+
+	for _, section := range config.Forms.Sections {
+		if section == nil {
+			continue
+		}
+
+		sectionItem := datasource_stack_forms.SectionItem{
+			Name:        types.StringPointerValue(section.Name),
+			Description: types.StringValue(section.Description),
+			Groups:      make([]datasource_stack_forms.GroupItem, 0, len(section.Groups)),
+		}
+
+		for _, group := range section.Groups {
+			if group == nil {
+				continue
+			}
+
+			groupItem := datasource_stack_forms.GroupItem{
+				Name:             types.StringPointerValue(group.Name),
+				Description:      types.StringValue(group.Description),
+				Condition:        types.StringValue(group.Condition),
+				Technologies:     append([]string{}, group.Technologies...),
+				Folded:           types.BoolValue(group.Folded),
+				ReadOnly:         types.BoolValue(group.ReadOnly),
+				ReadOnlyOnUpdate: types.BoolValue(group.ReadOnlyOnUpdate),
+				Vars:             make([]datasource_stack_forms.VarItem, 0, len(group.Vars)),
+			}
+
+			for _, entity := range group.Vars {
+				if entity == nil {
+					continue
+				}
+				groupItem.Vars = append(groupItem.Vars, stackFormsEntityToItem(entity))
+			}
+
+			sectionItem.Groups = append(sectionItem.Groups, groupItem)
+		}
+
+		item.Sections = append(item.Sections, sectionItem)
+	}
+
+	return item
+}
+
+func stackFormsEntityToItem(entity *models.FormEntity) datasource_stack_forms.VarItem {
+	var items any
+	if len(entity.Items) > 0 {
+		items = entity.Items
+	}
+
+	var validations any
+	if len(entity.Validations) > 0 {
+		validations = entity.Validations
+	}
+
+	return datasource_stack_forms.VarItem{
+		Key:              types.StringValue(entity.Key),
+		Name:             types.StringPointerValue(entity.Name),
+		Description:      types.StringValue(entity.Description),
+		Widget:           types.StringPointerValue(entity.Widget),
+		Type:             types.StringValue(entity.Type),
+		Unit:             types.StringValue(entity.Unit),
+		Required:         types.BoolValue(entity.Required),
+		Condition:        types.StringValue(entity.Condition),
+		DependsOn:        append([]string{}, entity.DependsOn...),
+		Folded:           types.BoolValue(entity.Folded),
+		ReadOnly:         types.BoolValue(entity.ReadOnly),
+		ReadOnlyOnUpdate: types.BoolValue(entity.ReadOnlyOnUpdate),
+		ValuesRef:        types.StringValue(entity.ValuesRef),
+		Default:          anyToJSONString(entity.Default),
+		Values:           anyToJSONString(entity.Values),
+		Validations:      anyToJSONString(validations),
+		WidgetConfig:     anyToJSONString(entity.WidgetConfig),
+		Items:            anyToJSONString(items),
+	}
+}
+
+// anyToJSONString encodes a free-form API value as a JSON string attribute,
+// null when the API did not return it.
+func anyToJSONString(v any) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return types.StringValue(fmt.Sprintf("%v", v))
+	}
+
+	return types.StringValue(string(b))
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_team"
+)
+
+var _ datasource.DataSource = (*teamDataSource)(nil)
+
+type teamDataSource struct {
+	provider *CycloidProvider
+}
+
+type teamDatasourceModel = datasource_team.TeamModel
+
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+func (s *teamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (s *teamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_team.TeamDataSourceSchema(ctx)
+}
+
+func (s *teamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data teamDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+	canonical := data.Canonical.ValueString()
+
+	team, _, err := s.provider.Client.GetTeam(org, canonical)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read the team %q", canonical), err.Error())
+		return
+	}
+
+	item, diags := teamToItem(ctx, team)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamModel = item
+	data.Organization = types.StringValue(org)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_team_members"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+var _ datasource.DataSource = (*teamMembersDataSource)(nil)
+
+type teamMembersDataSource struct {
+	provider *CycloidProvider
+}
+
+type teamMembersDatasourceModel = datasource_team_members.TeamMembersModel
+
+func NewTeamMembersDataSource() datasource.DataSource {
+	return &teamMembersDataSource{}
+}
+
+func (s *teamMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (s *teamMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_team_members.TeamMembersDataSourceSchema(ctx)
+}
+
+func (s *teamMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *teamMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data teamMembersDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+	team := data.Team.ValueString()
+
+	filters, diags := lhsfilter.FromList(ctx, data.Filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, _, err := s.provider.Client.ListTeamMembers(org, team, filters...)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list the members of the team %q", team), err.Error())
+		return
+	}
+
+	items := make([]datasource_team_members.MemberModel, 0, len(members))
+	for _, m := range members {
+		email := types.StringNull()
+		if m.Email != nil {
+			email = types.StringValue(m.Email.String())
+		}
+		items = append(items, datasource_team_members.MemberModel{
+			MemberID:   types.Int64Value(int64(ptr.Value(m.ID))),
+			Username:   types.StringValue(m.Username),
+			Email:      email,
+			FullName:   types.StringValue(m.FullName),
+			MfaEnabled: types.BoolValue(ptr.Value(m.MfaEnabled)),
+		})
+	}
+
+	data.Organization = types.StringValue(org)
+	data.Members, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_team_members.MemberAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
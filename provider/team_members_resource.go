@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/cmd/apiclient"
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/resource_team_members"
+)
+
+var (
+	_ resource.Resource                = (*teamMembersResource)(nil)
+	_ resource.ResourceWithImportState = (*teamMembersResource)(nil)
+)
+
+type teamMembersResourceModel = resource_team_members.TeamMembersModel
+
+func NewTeamMembersResource() resource.Resource {
+	return &teamMembersResource{}
+}
+
+type teamMembersResource struct {
+	provider *CycloidProvider
+}
+
+func (r *teamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *teamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_team_members.TeamMembersResourceSchema(ctx)
+}
+
+func (r *teamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = pv
+}
+
+func (r *teamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *teamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := r.provider.Client
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	team := data.Team.ValueString()
+
+	emails, ids, diags := teamMembersFromData(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, _, err := m.ListTeamMembers(org, team)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list the members of the team %q in org %q", team, org), err.Error())
+		return
+	}
+
+	// The emails and IDs keep the form they have in the state, the members
+	// which left the team are dropped and, in the authoritative mode, the
+	// members unknown to the state are added by ID so they show up as drift
+	var readEmails []string
+	for _, e := range emails {
+		if slices.ContainsFunc(current, func(tm *models.MemberTeam) bool { return teamMemberHasEmail(tm, e) }) {
+			readEmails = append(readEmails, e)
+		}
+	}
+	var readIDs []int64
+	for _, id := range ids {
+		if slices.ContainsFunc(current, func(tm *models.MemberTeam) bool { return int64(ptr.Value(tm.ID)) == id }) {
+			readIDs = append(readIDs, id)
+		}
+	}
+	if data.Mode.ValueString() != resource_team_members.ModeAdditive {
+		for _, tm := range current {
+			if !teamMemberMatches(tm, emails, ids) {
+				readIDs = append(readIDs, int64(ptr.Value(tm.ID)))
+			}
+		}
+	}
+
+	if len(readEmails) > 0 || !data.Emails.IsNull() {
+		data.Emails, diags = types.SetValueFrom(ctx, types.StringType, readEmails)
+		resp.Diagnostics.Append(diags...)
+	}
+	if len(readIDs) > 0 || !data.MemberIDs.IsNull() {
+		data.MemberIDs, diags = types.SetValueFrom(ctx, types.Int64Type, readIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Organization = types.StringValue(org)
+	resp.Diagnostics.Append(teamMembersToData(ctx, current, readEmails, readIDs, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *teamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *teamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m := r.provider.Client
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	team := data.Team.ValueString()
+
+	emails, ids, diags := teamMembersFromData(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, _, err := m.ListTeamMembers(org, team)
+	if err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list the members of the team %q in org %q", team, org), err.Error())
+		return
+	}
+
+	for _, tm := range current {
+		if teamMemberMatches(tm, emails, ids) {
+			resp.Diagnostics.Append(unassignTeamMember(m, org, team, tm)...)
+		}
+	}
+}
+
+func (r *teamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	org, team, ok := strings.Cut(req.ID, ":")
+	if !ok || org == "" || team == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <organization_canonical>:<team_canonical>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), org)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), resource_team_members.ModeAuthoritative)...)
+}
+
+// apply assigns the members of 'data' missing from the team and unassigns
+// the ones which must not be in it: the members not in 'data' in the
+// authoritative mode, and the ones removed from the 'prior' state in the
+// additive mode.
+func (r *teamMembersResource) apply(ctx context.Context, data, prior *teamMembersResourceModel) diag.Diagnostics {
+	m := r.provider.Client
+	org := getOrganizationCanonical(*r.provider, data.Organization)
+	team := data.Team.ValueString()
+
+	emails, ids, diags := teamMembersFromData(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, _, err := m.ListTeamMembers(org, team)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to list the members of the team %q in org %q", team, org), err.Error())
+		return diags
+	}
+
+	for _, e := range emails {
+		if slices.ContainsFunc(current, func(tm *models.MemberTeam) bool { return teamMemberHasEmail(tm, e) }) {
+			continue
+		}
+		if _, _, err := m.AssignMemberToTeam(org, team, nil, &e); err != nil {
+			diags.AddError(fmt.Sprintf("failed to assign team member %q to team %q in org %q", e, team, org), err.Error())
+		}
+	}
+	for _, id := range ids {
+		if slices.ContainsFunc(current, func(tm *models.MemberTeam) bool { return int64(ptr.Value(tm.ID)) == id }) {
+			continue
+		}
+		// The API assigns members by username or email only
+		member, _, err := m.GetMember(org, uint32(id))
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to read the member %d in org %q", id, org), err.Error())
+			continue
+		}
+		email := member.Email.String()
+		if _, _, err := m.AssignMemberToTeam(org, team, nilIfEmpty(member.Username), nilIfEmpty(email)); err != nil {
+			diags.AddError(fmt.Sprintf("failed to assign team member %d to team %q in org %q", id, team, org), err.Error())
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var priorEmails []string
+	var priorIDs []int64
+	if prior != nil {
+		priorEmails, priorIDs, diags = teamMembersFromData(ctx, prior)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	additive := data.Mode.ValueString() == resource_team_members.ModeAdditive
+	for _, tm := range current {
+		if teamMemberMatches(tm, emails, ids) {
+			continue
+		}
+		if additive && !teamMemberMatches(tm, priorEmails, priorIDs) {
+			continue
+		}
+		diags.Append(unassignTeamMember(m, org, team, tm)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	current, _, err = m.ListTeamMembers(org, team)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to list the members of the team %q in org %q", team, org), err.Error())
+		return diags
+	}
+
+	data.Organization = types.StringValue(org)
+	diags.Append(teamMembersToData(ctx, current, emails, ids, data)...)
+	return diags
+}
+
+// teamMembersFromData returns the emails and member IDs of 'data'.
+func teamMembersFromData(ctx context.Context, data *teamMembersResourceModel) ([]string, []int64, diag.Diagnostics) {
+	var (
+		emails []string
+		ids    []int64
+		diags  diag.Diagnostics
+	)
+	if !data.Emails.IsNull() && !data.Emails.IsUnknown() {
+		diags.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+	}
+	if !data.MemberIDs.IsNull() && !data.MemberIDs.IsUnknown() {
+		diags.Append(data.MemberIDs.ElementsAs(ctx, &ids, false)...)
+	}
+	return emails, ids, diags
+}
+
+// teamMembersToData sets the members of 'data' to the members of 'current'
+// matching the 'emails' or 'ids'.
+func teamMembersToData(ctx context.Context, current []*models.MemberTeam, emails []string, ids []int64, data *teamMembersResourceModel) diag.Diagnostics {
+	members := make([]resource_team_members.MemberModel, 0, len(current))
+	for _, tm := range current {
+		if !teamMemberMatches(tm, emails, ids) {
+			continue
+		}
+		members = append(members, resource_team_members.MemberModel{
+			MemberID: types.Int64Value(int64(ptr.Value(tm.ID))),
+			Username: types.StringValue(tm.Username),
+			Email:    types.StringValue(ptr.Value(tm.Email).String()),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Members, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: resource_team_members.MemberAttrTypes()}, members)
+	return diags
+}
+
+// teamMemberHasEmail returns whether the 'tm' has the 'email', emails being
+// case insensitive.
+func teamMemberHasEmail(tm *models.MemberTeam, email string) bool {
+	return tm.Email != nil && strings.EqualFold(tm.Email.String(), email)
+}
+
+// teamMemberMatches returns whether the 'tm' has one of the 'emails' or
+// 'ids'.
+func teamMemberMatches(tm *models.MemberTeam, emails []string, ids []int64) bool {
+	if slices.Contains(ids, int64(ptr.Value(tm.ID))) {
+		return true
+	}
+	return slices.ContainsFunc(emails, func(e string) bool { return teamMemberHasEmail(tm, e) })
+}
+
+func unassignTeamMember(m apiclient.APIClient, org, team string, tm *models.MemberTeam) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if _, err := m.UnAssignMemberFromTeam(org, team, ptr.Value(tm.ID)); err != nil && !isNotFoundError(err) {
+		diags.AddError(fmt.Sprintf("failed to unassign member %q from team %q in org %q", Coalesce(tm.Username, ptr.Value(tm.Email).String()), team, org), err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/cycloid-cli/gen/models"
+	"github.com/cycloidio/cycloid-cli/utils/ptr"
+	"github.com/cycloidio/terraform-provider-cycloid/datasource_teams"
+	"github.com/cycloidio/terraform-provider-cycloid/internal/lhsfilter"
+)
+
+var _ datasource.DataSource = (*teamsDataSource)(nil)
+
+type teamsDataSource struct {
+	provider *CycloidProvider
+}
+
+type teamsDatasourceModel = datasource_teams.TeamsModel
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &teamsDataSource{}
+}
+
+func (s *teamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (s *teamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_teams.TeamsDataSourceSchema(ctx)
+}
+
+func (s *teamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pv, ok := req.ProviderData.(*CycloidProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider data at Configure()",
+			fmt.Sprintf("Expected *CycloidProvider, got: %T. Please report this issue.", req.ProviderData),
+		)
+		return
+	}
+
+	s.provider = pv
+}
+
+func (s *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data teamsDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := getOrganizationCanonical(*s.provider, data.Organization)
+
+	filters, diags := lhsfilter.FromList(ctx, data.Filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberID *uint32
+	if !data.MemberID.IsNull() {
+		memberID = ptr.Ptr(uint32(data.MemberID.ValueInt64()))
+	}
+
+	teams, _, err := s.provider.Client.ListTeams(org, data.Name.ValueStringPointer(), nil, memberID, nil, filters...)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list teams", err.Error())
+		return
+	}
+
+	items := make([]datasource_teams.TeamModel, 0, len(teams))
+	for _, t := range teams {
+		item, diags := teamToItem(ctx, t)
+		resp.Diagnostics.Append(diags...)
+		items = append(items, item)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Organization = types.StringValue(org)
+	data.Teams, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_teams.TeamAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func teamToItem(ctx context.Context, team *models.Team) (datasource_teams.TeamModel, diag.Diagnostics) {
+	roles := make([]string, 0, len(team.Roles))
+	for _, r := range team.Roles {
+		roles = append(roles, ptr.Value(r.Canonical))
+	}
+	rolesValue, diags := types.ListValueFrom(ctx, types.StringType, roles)
+
+	return datasource_teams.TeamModel{
+		Canonical:   types.StringPointerValue(team.Canonical),
+		Name:        types.StringPointerValue(team.Name),
+		TeamID:      types.Int64Value(int64(ptr.Value(team.ID))),
+		Owner:       types.StringPointerValue(ptr.Value(team.Owner).Username),
+		Roles:       rolesValue,
+		MemberCount: types.Int64Value(int64(ptr.Value(team.MemberCount))),
+	}, diags
+}
@@ -4,6 +4,10 @@
 // added by hand because the generator does not support Default values. The
 // Schema() method in provider/catalog_repository_resource.go overrides the
 // generated schema entry with Optional+Computed+Default(true).
+//
+// sync_status and validate_connection were added by hand the same way;
+// Schema() sets the validate_connection Default(false). version_policy and
+// versions were added by hand too.
 
 package resource_catalog_repository
 
@@ -22,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/cycloidio/terraform-provider-cycloid/internal/versionpolicy"
 )
 
 func CatalogRepositoryResourceSchema(ctx context.Context) schema.Schema {
@@ -138,6 +144,28 @@ func CatalogRepositoryResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "User canonical that owns this catalog repository. If omitted then the person creating this catalog repository will be assigned as owner. When a user is the owner of a catalog repository they have all the permissions on it.",
 				MarkdownDescription: "User canonical that owns this catalog repository. If omitted then the person creating this catalog repository will be assigned as owner. When a user is the owner of a catalog repository they have all the permissions on it.",
 			},
+			"sync_status": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"last_error": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The error returned by the last version refresh triggered by Terraform, empty when it succeeded.",
+						MarkdownDescription: "The error returned by the last version refresh triggered by Terraform, empty when it succeeded.",
+					},
+					"refreshed_at": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Unix timestamp of the last time Cycloid indexed the branches and tags of the repository, whether the refresh was triggered by Terraform or by the background cron.",
+						MarkdownDescription: "Unix timestamp of the last time Cycloid indexed the branches and tags of the repository, whether the refresh was triggered by Terraform or by the background cron.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						Description:         "Outcome of the last version refresh triggered by Terraform: not_requested when Terraform never refreshed the repository, then succeeded or failed.",
+						MarkdownDescription: "Outcome of the last version refresh triggered by Terraform: `not_requested` when Terraform never refreshed the repository, then `succeeded` or `failed`.",
+					},
+				},
+				Computed:            true,
+				Description:         "Synchronization status of the branches and tags indexed from the repository. The API does not report the outcome of the background refreshes, so state and last_error only cover the refreshes triggered by Terraform through refresh_on_create.",
+				MarkdownDescription: "Synchronization status of the branches and tags indexed from the repository. The API does not report the outcome of the background refreshes, so `state` and `last_error` only cover the refreshes triggered by Terraform through `refresh_on_create`.",
+			},
 			"url": schema.StringAttribute{
 				Required:            true,
 				Description:         "Git URL of the catalog repository. SSH and HTTPS formats are accepted.",
@@ -146,6 +174,46 @@ func CatalogRepositoryResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^((/|~)[^/]*)+.(\\.git)|(([\\w\\]+@[\\w\\.]+))(:(//)?)([\\w\\.@\\:/\\-~]+)(/)?"), ""),
 				},
 			},
+			"version_policy": versionpolicy.Attribute("Stack versions accepted for the stacks of this catalog repository. Cycloid does not store the policy: it is checked at plan time on the `cycloid_component` resources that reference it in their `catalog_version_policy`."),
+			"versions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit_hash": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The commit the version points to.",
+							MarkdownDescription: "The commit the version points to.",
+						},
+						"is_latest": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether this is the latest version.",
+							MarkdownDescription: "Whether this is the latest version.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the tag or branch.",
+							MarkdownDescription: "The name of the tag or branch.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The status of the version: no_status, active, deleted or outdated.",
+							MarkdownDescription: "The status of the version: `no_status`, `active`, `deleted` or `outdated`.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of the version: tag or branch.",
+							MarkdownDescription: "The type of the version: `tag` or `branch`.",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The tags and branches indexed from the repository, usable as stack_version of the components using its stacks.",
+				MarkdownDescription: "The tags and branches indexed from the repository, usable as `stack_version` of the components using its stacks.",
+			},
+			"validate_connection": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "When true, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists.",
+				MarkdownDescription: "When `true`, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists.",
+			},
 		},
 	}
 }
@@ -161,7 +229,40 @@ type CatalogRepositoryModel struct {
 	OrganizationCanonical types.String `tfsdk:"organization_canonical"`
 	Owner                 types.String `tfsdk:"owner"`
 	RefreshOnCreate       types.Bool   `tfsdk:"refresh_on_create"`
+	SyncStatus            types.Object `tfsdk:"sync_status"`
 	Url                   types.String `tfsdk:"url"`
+	ValidateConnection    types.Bool   `tfsdk:"validate_connection"`
+	VersionPolicy         types.Object `tfsdk:"version_policy"`
+	Versions              types.List   `tfsdk:"versions"`
+}
+
+type VersionItem struct {
+	CommitHash types.String `tfsdk:"commit_hash"`
+	IsLatest   types.Bool   `tfsdk:"is_latest"`
+	Name       types.String `tfsdk:"name"`
+	Status     types.String `tfsdk:"status"`
+	Type       types.String `tfsdk:"type"`
+}
+
+// VersionAttrTypes returns the attribute types of a versions item.
+func VersionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"commit_hash": types.StringType,
+		"is_latest":   types.BoolType,
+		"name":        types.StringType,
+		"status":      types.StringType,
+		"type":        types.StringType,
+	}
+}
+
+// SyncStatusAttrTypes returns the attribute types of the
+// sync_status object.
+func SyncStatusAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"last_error":   types.StringType,
+		"refreshed_at": types.Int64Type,
+		"state":        types.StringType,
+	}
 }
 
 var _ basetypes.ObjectTypable = DataType{}
@@ -0,0 +1,153 @@
+package resource_catalog_repository_stacks_policy
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var canonicalRegex = regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`)
+
+func CatalogRepositoryStacksPolicyResourceSchema(ctx context.Context) schema.Schema {
+	desc := strings.Join([]string{
+		"Enforces `visibility` and `team` on every stack of a catalog repository, including stacks that already exist.",
+		"Stacks are enumerated from the catalog repository on each plan, so stacks added to the repository or removed from `exclude` are picked up by the next plan.",
+		"Stacks listed in `exclude` are left untouched, and `overrides` sets different values for specific stacks.",
+		"Destroying this resource only removes it from the state, the stacks keep their current settings.",
+	}, "\n")
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "Organization canonical of the catalog repository. Defaults to provider `default_organization`.",
+				MarkdownDescription: "Organization canonical of the catalog repository. Defaults to provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"catalog_repository_canonical": schema.StringAttribute{
+				Description:         "The canonical of the catalog repository whose stacks are managed.",
+				MarkdownDescription: "The canonical of the catalog repository whose stacks are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				Description:         "The visibility enforced on the stacks. When omitted, the visibility of the stacks is not managed.",
+				MarkdownDescription: "The visibility enforced on the stacks, one of `local`, `shared` or `hidden`. When omitted, the visibility of the stacks is not managed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("local", "shared", "hidden"),
+				},
+			},
+			"team": schema.StringAttribute{
+				Description:         "The canonical of the team set as maintainer of the stacks. An empty string removes the team. When omitted, the team of the stacks is not managed.",
+				MarkdownDescription: "The canonical of the team set as maintainer of the stacks. An empty string removes the team. When omitted, the team of the stacks is not managed.",
+				Optional:            true,
+			},
+			"exclude": schema.SetAttribute{
+				Description:         "Canonicals of the stacks left untouched by this policy. Exclusions take precedence over overrides.",
+				MarkdownDescription: "Canonicals of the stacks left untouched by this policy. Exclusions take precedence over `overrides`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"overrides": schema.MapNestedAttribute{
+				Description:         "Per-stack settings, keyed by stack canonical. An attribute omitted in an override falls back to the policy value.",
+				MarkdownDescription: "Per-stack settings, keyed by stack canonical. An attribute omitted in an override falls back to the policy value.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"visibility": schema.StringAttribute{
+							Description:         "The visibility of this stack.",
+							MarkdownDescription: "The visibility of this stack, one of `local`, `shared` or `hidden`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("local", "shared", "hidden"),
+							},
+						},
+						"team": schema.StringAttribute{
+							Description:         "The canonical of the team maintaining this stack. An empty string removes the team.",
+							MarkdownDescription: "The canonical of the team maintaining this stack. An empty string removes the team.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"stacks": schema.MapNestedAttribute{
+				Description:         "The stacks managed by this policy, keyed by stack canonical, with their current settings. A stack whose settings drifted from the policy shows up as a change of its entry in the plan.",
+				MarkdownDescription: "The stacks managed by this policy, keyed by stack canonical, with their current settings. A stack whose settings drifted from the policy shows up as a change of its entry in the plan.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ref": schema.StringAttribute{
+							Description:         "The stack reference, `organization:canonical`.",
+							MarkdownDescription: "The stack reference, `organization:canonical`.",
+							Computed:            true,
+						},
+						"visibility": schema.StringAttribute{
+							Description:         "The visibility of the stack.",
+							MarkdownDescription: "The visibility of the stack.",
+							Computed:            true,
+						},
+						"team": schema.StringAttribute{
+							Description:         "The canonical of the team maintaining the stack, empty when none.",
+							MarkdownDescription: "The canonical of the team maintaining the stack, empty when none.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type CatalogRepositoryStacksPolicyModel struct {
+	Organization               types.String `tfsdk:"organization"`
+	CatalogRepositoryCanonical types.String `tfsdk:"catalog_repository_canonical"`
+	Visibility                 types.String `tfsdk:"visibility"`
+	Team                       types.String `tfsdk:"team"`
+	Exclude                    types.Set    `tfsdk:"exclude"`
+	Overrides                  types.Map    `tfsdk:"overrides"`
+	Stacks                     types.Map    `tfsdk:"stacks"`
+}
+
+type OverrideModel struct {
+	Visibility types.String `tfsdk:"visibility"`
+	Team       types.String `tfsdk:"team"`
+}
+
+type StackModel struct {
+	Ref        types.String `tfsdk:"ref"`
+	Visibility types.String `tfsdk:"visibility"`
+	Team       types.String `tfsdk:"team"`
+}
+
+func StackAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ref":        types.StringType,
+		"visibility": types.StringType,
+		"team":       types.StringType,
+	}
+}
@@ -0,0 +1,272 @@
+package resource_cloud_account
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialBodyFields are the string fields of a credential body, the
+// custom type uses raw instead.
+var credentialBodyFields = []string{
+	"access_key", "account_name", "auth_url", "ca_cert", "client_id", "client_secret", "domain_id",
+	"environment", "json_key", "password", "secret_key", "ssh_key", "subscription_id", "tenant_id", "username",
+}
+
+// credentialWriteOnlyFields are the secret fields of a credential body that
+// have a write-only counterpart, as in cycloid_credential.
+var credentialWriteOnlyFields = []string{"client_secret", "json_key", "password", "secret_key", "ssh_key"}
+
+// CredentialAttribute is the credential created along with the cloud
+// account.
+func CredentialAttribute() schema.SingleNestedAttribute {
+	body := map[string]schema.Attribute{
+		"raw": schema.MapAttribute{
+			Description:         "Credential fields for type `custom`.",
+			MarkdownDescription: "Credential fields for type `custom`.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+	for _, f := range credentialBodyFields {
+		body[f] = schema.StringAttribute{
+			Description:         "See the `body` of the `cycloid_credential` resource.",
+			MarkdownDescription: "See the `body` of the [`cycloid_credential`](./credential.md) resource.",
+			Optional:            true,
+		}
+	}
+
+	attributes := map[string]schema.Attribute{
+		"raw_wo": schema.MapAttribute{
+			Description:         "Write-only counterpart of body.raw, for type custom. The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+			MarkdownDescription: "Write-only counterpart of `body.raw`, for type `custom`. The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			ElementType:         types.StringType,
+		},
+	}
+	bodyOrWriteOnly := []path.Expression{path.MatchRelative().AtParent().AtName("raw_wo")}
+	for _, f := range credentialWriteOnlyFields {
+		attributes[f+"_wo"] = schema.StringAttribute{
+			Description:         "Write-only counterpart of body." + f + ". The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+			MarkdownDescription: "Write-only counterpart of `body." + f + "`. The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+		}
+		bodyOrWriteOnly = append(bodyOrWriteOnly, path.MatchRelative().AtParent().AtName(f+"_wo"))
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         "The name of the credential. Defaults to the name of the cloud account.",
+		MarkdownDescription: "The name of the credential. Defaults to the name of the cloud account.",
+		Optional:            true,
+	}
+	attributes["canonical"] = schema.StringAttribute{
+		Description:         "The canonical of the credential, inferred from its name when omitted.",
+		MarkdownDescription: "The canonical of the credential, inferred from its name when omitted.",
+		Optional:            true,
+	}
+	attributes["path"] = schema.StringAttribute{
+		Description:         "The credential path written in vault and used in pipelines.",
+		MarkdownDescription: "The credential path written in vault and used in `pipelines`.",
+		Optional:            true,
+	}
+	attributes["description"] = schema.StringAttribute{
+		Description:         "The description of the credential.",
+		MarkdownDescription: "The description of the credential.",
+		Optional:            true,
+	}
+	attributes["type"] = schema.StringAttribute{
+		Description:         "The type of the credential, it must match the cloud provider for built-in providers.",
+		MarkdownDescription: "The type of the credential, it must match the cloud provider for built-in providers.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("aws", "azure", "azure_storage", "gcp", "custom", "vmware", "swift", "ssh", "basic_auth", "elasticsearch"),
+		},
+	}
+	attributes["body"] = schema.SingleNestedAttribute{
+		Description:         "The credential values, use the fields related to the credential type. The secrets can be given through the write-only *_wo attributes instead.",
+		MarkdownDescription: "The credential values, use the fields related to the credential `type`. The secrets can be given through the write-only `*_wo` attributes instead.",
+		Optional:            true,
+		Attributes:          body,
+		Validators: []validator.Object{
+			objectvalidator.AtLeastOneOf(bodyOrWriteOnly...),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		Description:         "A credential created together with the cloud account, instead of referencing an existing one with `credential_canonical`. The credential is only created with the cloud account, changing this block replaces both. Terraform cannot detect a change of the write-only attributes, they are only sent on creation.",
+		MarkdownDescription: "A credential created together with the cloud account, instead of referencing an existing one with `credential_canonical`. The credential is only created with the cloud account, changing this block replaces both. Terraform cannot detect a change of the write-only attributes, they are only sent on creation.",
+		Optional:            true,
+		Sensitive:           true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+		Attributes: attributes,
+	}
+}
+
+// ConfigurationAttribute is the cloud provider specific configuration, one
+// block per provider.
+func ConfigurationAttribute() schema.SingleNestedAttribute {
+	providers := []string{"aws", "gcp", "azure", "vsphere"}
+	onlyOne := func(self string) validator.Object {
+		var others []path.Expression
+		for _, p := range providers {
+			if p != self {
+				others = append(others, path.MatchRelative().AtParent().AtName(p))
+			}
+		}
+		return objectvalidator.ConflictsWith(others...)
+	}
+
+	return schema.SingleNestedAttribute{
+		Description:         "Cloud provider specific configuration, set the block matching cloud_provider. When omitted, the configuration of the cloud account is not managed.",
+		MarkdownDescription: "Cloud provider specific configuration, set the block matching `cloud_provider`. When omitted, the configuration of the cloud account is not managed.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"aws": schema.SingleNestedAttribute{
+				Description:         "Configuration of an AWS cloud account.",
+				MarkdownDescription: "Configuration of an AWS cloud account.",
+				Optional:            true,
+				Validators:          []validator.Object{onlyOne("aws")},
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description:         "The default AWS region.",
+						MarkdownDescription: "The default AWS region.",
+						Required:            true,
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				Description:         "Configuration of a GCP cloud account.",
+				MarkdownDescription: "Configuration of a GCP cloud account.",
+				Optional:            true,
+				Validators:          []validator.Object{onlyOne("gcp")},
+				Attributes: map[string]schema.Attribute{
+					"project": schema.StringAttribute{
+						Description:         "The GCP project.",
+						MarkdownDescription: "The GCP project.",
+						Required:            true,
+					},
+					"region": schema.StringAttribute{
+						Description:         "The default GCP region.",
+						MarkdownDescription: "The default GCP region.",
+						Required:            true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				Description:         "Configuration of an Azure cloud account.",
+				MarkdownDescription: "Configuration of an Azure cloud account.",
+				Optional:            true,
+				Validators:          []validator.Object{onlyOne("azure")},
+				Attributes: map[string]schema.Attribute{
+					"environment": schema.StringAttribute{
+						Description:         "The Azure environment, like public.",
+						MarkdownDescription: "The Azure environment, like `public`.",
+						Required:            true,
+					},
+					"resource_group_names": schema.ListAttribute{
+						Description:         "The Azure resource groups.",
+						MarkdownDescription: "The Azure resource groups.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"vsphere": schema.SingleNestedAttribute{
+				Description:         "Configuration of a VMware vSphere cloud account.",
+				MarkdownDescription: "Configuration of a VMware vSphere cloud account.",
+				Optional:            true,
+				Validators:          []validator.Object{onlyOne("vsphere")},
+				Attributes: map[string]schema.Attribute{
+					"server": schema.StringAttribute{
+						Description:         "The vSphere server.",
+						MarkdownDescription: "The vSphere server.",
+						Required:            true,
+					},
+					"allow_unverified_ssl": schema.BoolAttribute{
+						Description:         "Whether to accept an unverified TLS certificate from the server.",
+						MarkdownDescription: "Whether to accept an unverified TLS certificate from the server.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type CredentialModel struct {
+	Name           types.String `tfsdk:"name"`
+	Canonical      types.String `tfsdk:"canonical"`
+	Path           types.String `tfsdk:"path"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	Body           types.Object `tfsdk:"body"`
+	ClientSecretWo types.String `tfsdk:"client_secret_wo"`
+	JsonKeyWo      types.String `tfsdk:"json_key_wo"`
+	PasswordWo     types.String `tfsdk:"password_wo"`
+	RawWo          types.Map    `tfsdk:"raw_wo"`
+	SecretKeyWo    types.String `tfsdk:"secret_key_wo"`
+	SshKeyWo       types.String `tfsdk:"ssh_key_wo"`
+}
+
+type ConfigurationModel struct {
+	AWS     types.Object `tfsdk:"aws"`
+	GCP     types.Object `tfsdk:"gcp"`
+	Azure   types.Object `tfsdk:"azure"`
+	VSphere types.Object `tfsdk:"vsphere"`
+}
+
+type AWSConfigurationModel struct {
+	Region types.String `tfsdk:"region"`
+}
+
+type GCPConfigurationModel struct {
+	Project types.String `tfsdk:"project"`
+	Region  types.String `tfsdk:"region"`
+}
+
+type AzureConfigurationModel struct {
+	Environment        types.String `tfsdk:"environment"`
+	ResourceGroupNames types.List   `tfsdk:"resource_group_names"`
+}
+
+type VSphereConfigurationModel struct {
+	Server             types.String `tfsdk:"server"`
+	AllowUnverifiedSsl types.Bool   `tfsdk:"allow_unverified_ssl"`
+}
+
+func AWSConfigurationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{"region": types.StringType}
+}
+
+func GCPConfigurationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{"project": types.StringType, "region": types.StringType}
+}
+
+func AzureConfigurationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{"environment": types.StringType, "resource_group_names": types.ListType{ElemType: types.StringType}}
+}
+
+func VSphereConfigurationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{"server": types.StringType, "allow_unverified_ssl": types.BoolType}
+}
+
+func ConfigurationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"aws":     types.ObjectType{AttrTypes: AWSConfigurationAttrTypes()},
+		"gcp":     types.ObjectType{AttrTypes: GCPConfigurationAttrTypes()},
+		"azure":   types.ObjectType{AttrTypes: AzureConfigurationAttrTypes()},
+		"vsphere": types.ObjectType{AttrTypes: VSphereConfigurationAttrTypes()},
+	}
+}
@@ -70,13 +70,21 @@ func CloudAccountResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"credential_canonical": schema.StringAttribute{
-				Description:         "Canonical of the `cycloid_credential` to wrap. The credential type must match the cloud provider for built-in providers; any credential type is accepted for custom providers (typically `custom`).",
-				MarkdownDescription: "Canonical of the [`cycloid_credential`](./credential.md) to wrap. The credential type must match the cloud provider for built-in providers; any credential type is accepted for custom providers (typically `custom`).",
-				Required:            true,
+				Description:         "Canonical of the `cycloid_credential` to wrap. The credential type must match the cloud provider for built-in providers; any credential type is accepted for custom providers (typically `custom`). Exactly one of `credential_canonical` and `credential` must be set.",
+				MarkdownDescription: "Canonical of the [`cycloid_credential`](./credential.md) to wrap. The credential type must match the cloud provider for built-in providers; any credential type is accepted for custom providers (typically `custom`). Exactly one of `credential_canonical` and `credential` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("credential_canonical"),
+						path.MatchRoot("credential"),
+					),
 				},
 			},
+			"credential":    CredentialAttribute(),
+			"configuration": ConfigurationAttribute(),
 			"description": schema.StringAttribute{
 				Description:         "Free-form description of the cloud account.",
 				MarkdownDescription: "Free-form description of the cloud account.",
@@ -104,6 +112,8 @@ type CloudAccountModel struct {
 	Canonical           types.String `tfsdk:"canonical"`
 	CloudProvider       types.String `tfsdk:"cloud_provider"`
 	CredentialCanonical types.String `tfsdk:"credential_canonical"`
+	Credential          types.Object `tfsdk:"credential"`
+	Configuration       types.Object `tfsdk:"configuration"`
 	Description         types.String `tfsdk:"description"`
 	Owner               types.String `tfsdk:"owner"`
 	ID                  types.Int64  `tfsdk:"id"`
@@ -0,0 +1,262 @@
+package resource_cloud_cost_management_account
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CloudCostManagementAccountResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Enable FinOps on a `cycloid_cloud_account` by creating its Cloud Cost Management account. The account ingests the cost export of the cloud provider: an AWS Cost and Usage Report bucket, a GCP billing export or an Azure cost export. Destroying the resource disables FinOps on the cloud account.",
+		MarkdownDescription: "Enable FinOps on a [`cycloid_cloud_account`](./cloud_account.md) by creating its Cloud Cost Management account. The account ingests the cost export of the cloud provider: an AWS Cost and Usage Report bucket, a GCP billing export or an Azure cost export. Destroying the resource disables FinOps on the cloud account.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical where the cloud account lives. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical where the cloud account lives. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"cloud_account_canonical": schema.StringAttribute{
+				Description:         "Canonical of the cloud account to enable FinOps on. Changing it forces a replacement.",
+				MarkdownDescription: "Canonical of the [`cycloid_cloud_account`](./cloud_account.md) to enable FinOps on. Changing it forces a replacement.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Display name of the Cloud Cost Management account. Defaults to the name of the cloud account.",
+				MarkdownDescription: "Display name of the Cloud Cost Management account. Defaults to the name of the cloud account.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"canonical": schema.StringAttribute{
+				Description:         "Canonical of the Cloud Cost Management account. Inferred by the API when omitted. Changing the canonical forces a replacement.",
+				MarkdownDescription: "Canonical of the Cloud Cost Management account. Inferred by the API when omitted. Changing the canonical forces a replacement.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`),
+						"must match ^[a-z0-9]+[a-z0-9\\-_]+[a-z0-9]+$",
+					),
+				},
+			},
+			"credential_canonical": schema.StringAttribute{
+				Description:         "Canonical of the `cycloid_credential` used to read the cost export. Defaults to the credential of the cloud account.",
+				MarkdownDescription: "Canonical of the [`cycloid_credential`](./credential.md) used to read the cost export. Defaults to the credential of the cloud account.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"aws_cur": schema.SingleNestedAttribute{
+				Description:         "The S3 bucket receiving the AWS Cost and Usage Report. Exactly one of `aws_cur`, `gcp_billing_export` and `azure_cost_export` must be set.",
+				MarkdownDescription: "The S3 bucket receiving the AWS Cost and Usage Report. Exactly one of `aws_cur`, `gcp_billing_export` and `azure_cost_export` must be set.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRoot("aws_cur"),
+						path.MatchRoot("gcp_billing_export"),
+						path.MatchRoot("azure_cost_export"),
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Description:         "The bucket of the report.",
+						MarkdownDescription: "The bucket of the report.",
+						Required:            true,
+					},
+					"region": schema.StringAttribute{
+						Description:         "The region of the bucket.",
+						MarkdownDescription: "The region of the bucket.",
+						Required:            true,
+					},
+					"prefix": schema.StringAttribute{
+						Description:         "The report path prefix configured on the report.",
+						MarkdownDescription: "The report path prefix configured on the report.",
+						Optional:            true,
+					},
+				},
+			},
+			"gcp_billing_export": schema.SingleNestedAttribute{
+				Description:         "The BigQuery table of the GCP billing export.",
+				MarkdownDescription: "The BigQuery table of the GCP billing export.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Description:         "The project containing the BigQuery dataset.",
+						MarkdownDescription: "The project containing the BigQuery dataset.",
+						Required:            true,
+					},
+					"dataset": schema.StringAttribute{
+						Description:         "The BigQuery dataset of the export.",
+						MarkdownDescription: "The BigQuery dataset of the export.",
+						Required:            true,
+					},
+					"table": schema.StringAttribute{
+						Description:         "The BigQuery table of the export.",
+						MarkdownDescription: "The BigQuery table of the export.",
+						Required:            true,
+					},
+				},
+			},
+			"azure_cost_export": schema.SingleNestedAttribute{
+				Description:         "The Azure cost export, read from a storage account.",
+				MarkdownDescription: "The Azure cost export, read from a storage account.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"blob_service_url": schema.StringAttribute{
+						Description:         "The blob service endpoint of the storage account containing the export files.",
+						MarkdownDescription: "The blob service endpoint of the storage account containing the export files.",
+						Required:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "The name of the export.",
+						MarkdownDescription: "The name of the export.",
+						Required:            true,
+					},
+					"scope": schema.StringAttribute{
+						Description:         "The scope of the export, like `/subscriptions/<id>`.",
+						MarkdownDescription: "The scope of the export, like `/subscriptions/<id>`.",
+						Required:            true,
+					},
+				},
+			},
+			"linked_account_names": schema.MapAttribute{
+				Description:         "Display names of the linked accounts, keyed by their ID on the cloud provider. Linked accounts are discovered by the ingestion, names of accounts not discovered yet are applied once they are.",
+				MarkdownDescription: "Display names of the linked accounts, keyed by their ID on the cloud provider. Linked accounts are discovered by the ingestion, names of accounts not discovered yet are applied once they are.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"linked_accounts": schema.ListNestedAttribute{
+				Description:         "The linked accounts of the organization for the cloud provider of this account.",
+				MarkdownDescription: "The linked accounts of the organization for the cloud provider of this account.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "Internal numeric ID of the linked account.",
+							MarkdownDescription: "Internal numeric ID of the linked account.",
+							Computed:            true,
+						},
+						"account_id": schema.StringAttribute{
+							Description:         "The ID of the account on the cloud provider.",
+							MarkdownDescription: "The ID of the account on the cloud provider.",
+							Computed:            true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							Description:         "The cloud provider of the account.",
+							MarkdownDescription: "The cloud provider of the account.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "The display name of the account.",
+							MarkdownDescription: "The display name of the account.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Description:         "The ID of the account on the cloud provider.",
+				MarkdownDescription: "The ID of the account on the cloud provider.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Description:         "The ingestion status: `idle`, `import` or `error`.",
+				MarkdownDescription: "The ingestion status: `idle`, `import` or `error`.",
+				Computed:            true,
+			},
+			"status_message": schema.StringAttribute{
+				Description:         "Details about the ingestion status, like the error of the last ingestion.",
+				MarkdownDescription: "Details about the ingestion status, like the error of the last ingestion.",
+				Computed:            true,
+			},
+			"last_ingestion_ended_at": schema.Int64Attribute{
+				Description:         "Unix timestamp of the end of the last ingestion.",
+				MarkdownDescription: "Unix timestamp of the end of the last ingestion.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				Description:         "Internal numeric ID assigned by the Cycloid API.",
+				MarkdownDescription: "Internal numeric ID assigned by the Cycloid API.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+type CloudCostManagementAccountModel struct {
+	Organization          types.String `tfsdk:"organization"`
+	CloudAccountCanonical types.String `tfsdk:"cloud_account_canonical"`
+	Name                  types.String `tfsdk:"name"`
+	Canonical             types.String `tfsdk:"canonical"`
+	CredentialCanonical   types.String `tfsdk:"credential_canonical"`
+	AwsCur                types.Object `tfsdk:"aws_cur"`
+	GcpBillingExport      types.Object `tfsdk:"gcp_billing_export"`
+	AzureCostExport       types.Object `tfsdk:"azure_cost_export"`
+	LinkedAccountNames    types.Map    `tfsdk:"linked_account_names"`
+	LinkedAccounts        types.List   `tfsdk:"linked_accounts"`
+	AccountID             types.String `tfsdk:"account_id"`
+	Status                types.String `tfsdk:"status"`
+	StatusMessage         types.String `tfsdk:"status_message"`
+	LastIngestionEndedAt  types.Int64  `tfsdk:"last_ingestion_ended_at"`
+	ID                    types.Int64  `tfsdk:"id"`
+}
+
+type AwsCurModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Region types.String `tfsdk:"region"`
+	Prefix types.String `tfsdk:"prefix"`
+}
+
+type GcpBillingExportModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Dataset   types.String `tfsdk:"dataset"`
+	Table     types.String `tfsdk:"table"`
+}
+
+type AzureCostExportModel struct {
+	BlobServiceURL types.String `tfsdk:"blob_service_url"`
+	Name           types.String `tfsdk:"name"`
+	Scope          types.String `tfsdk:"scope"`
+}
+
+func AwsCurAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{"bucket": types.StringType, "region": types.StringType, "prefix": types.StringType}
+}
+
+func GcpBillingExportAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{"project_id": types.StringType, "dataset": types.StringType, "table": types.StringType}
+}
+
+func AzureCostExportAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{"blob_service_url": types.StringType, "name": types.StringType, "scope": types.StringType}
+}
+
+func LinkedAccountAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.Int64Type,
+		"account_id":     types.StringType,
+		"cloud_provider": types.StringType,
+		"name":           types.StringType,
+	}
+}
@@ -0,0 +1,74 @@
+package resource_cloud_cost_tag_mapping
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CloudCostTagMappingResourceSchema(ctx context.Context) schema.Schema {
+	tagsAttribute := func(entity string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Description:         "The cloud resource tag keys holding the " + entity + " canonical, in priority order.",
+			MarkdownDescription: "The cloud resource tag keys holding the " + entity + " canonical, in priority order.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		}
+	}
+	regexAttribute := func(entity string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description:         "A regular expression extracting the " + entity + " canonical from the tag value, its first capture group is used.",
+			MarkdownDescription: "A regular expression extracting the " + entity + " canonical from the tag value, its first capture group is used.",
+			Optional:            true,
+		}
+	}
+
+	return schema.Schema{
+		Description:         "Manage how Cloud Cost Management maps the tags of cloud resources to the projects, environments and components of an organization. There is a single tag mapping per organization, destroying the resource resets it.",
+		MarkdownDescription: "Manage how Cloud Cost Management maps the tags of cloud resources to the projects, environments and components of an organization. There is a single tag mapping per organization, destroying the resource resets it.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the tag mapping. Defaults to the provider's `default_organization`.",
+				MarkdownDescription: "The organization canonical of the tag mapping. Defaults to the provider's `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"project_tags":      tagsAttribute("project"),
+			"project_regex":     regexAttribute("project"),
+			"environment_tags":  tagsAttribute("environment"),
+			"environment_regex": regexAttribute("environment"),
+			"component_tags":    tagsAttribute("component"),
+			"component_regex":   regexAttribute("component"),
+			"all_tags": schema.ListAttribute{
+				Description:         "All the tag keys found on the cloud resources of the organization.",
+				MarkdownDescription: "All the tag keys found on the cloud resources of the organization.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.Int64Attribute{
+				Description:         "Internal numeric ID assigned by the Cycloid API.",
+				MarkdownDescription: "Internal numeric ID assigned by the Cycloid API.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type CloudCostTagMappingModel struct {
+	Organization     types.String `tfsdk:"organization"`
+	ProjectTags      types.List   `tfsdk:"project_tags"`
+	ProjectRegex     types.String `tfsdk:"project_regex"`
+	EnvironmentTags  types.List   `tfsdk:"environment_tags"`
+	EnvironmentRegex types.String `tfsdk:"environment_regex"`
+	ComponentTags    types.List   `tfsdk:"component_tags"`
+	ComponentRegex   types.String `tfsdk:"component_regex"`
+	AllTags          types.List   `tfsdk:"all_tags"`
+	ID               types.Int64  `tfsdk:"id"`
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cycloidio/terraform-provider-cycloid/internal/versionpolicy"
 )
 
 func ComponentResourceSchema(ctx context.Context) schema.Schema {
@@ -170,6 +172,8 @@ func ComponentResourceSchema(ctx context.Context) schema.Schema {
 					"Section and group names must match the `name` attribute in the stack's stackforms configuration.",
 				}, "\n"),
 			},
+			"catalog_version_policy": versionpolicy.Attribute("The `version_policy` of the catalog repository of the stack, set it to `cycloid_catalog_repository.<name>.version_policy`. Cycloid does not store the policy, this reference is how the component reads it on every plan."),
+			"version_policy":         versionpolicy.Attribute("Narrows `catalog_version_policy` for this component: `stack_version` must be accepted by both policies. When a policy applies, `stack_version` is required and is checked at plan time on creation and whenever `stack_ref`, `stack_version` or either policy change."),
 			"current_config": schema.DynamicAttribute{
 				Computed:            true,
 				Sensitive:           true,
@@ -181,18 +185,20 @@ func ComponentResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type ComponentModel struct {
-	Organization        types.String  `tfsdk:"organization"`
-	Project             types.String  `tfsdk:"project"`
-	Environment         types.String  `tfsdk:"environment"`
-	Name                types.String  `tfsdk:"name"`
-	Canonical           types.String  `tfsdk:"canonical"`
-	Description         types.String  `tfsdk:"description"`
-	StackRef            types.String  `tfsdk:"stack_ref"`
-	StackVersion        types.String  `tfsdk:"stack_version"`
-	UseCase             types.String  `tfsdk:"use_case"`
-	AllowVersionUpdate  types.Bool    `tfsdk:"allow_version_update"`
-	AllowVariableUpdate types.Bool    `tfsdk:"allow_variable_update"`
-	AllowDestroy        types.Bool    `tfsdk:"allow_destroy"`
-	InputVariables      types.Dynamic `tfsdk:"input_variables"`
-	CurrentConfig       types.Dynamic `tfsdk:"current_config"`
+	Organization         types.String  `tfsdk:"organization"`
+	Project              types.String  `tfsdk:"project"`
+	Environment          types.String  `tfsdk:"environment"`
+	Name                 types.String  `tfsdk:"name"`
+	Canonical            types.String  `tfsdk:"canonical"`
+	Description          types.String  `tfsdk:"description"`
+	StackRef             types.String  `tfsdk:"stack_ref"`
+	StackVersion         types.String  `tfsdk:"stack_version"`
+	UseCase              types.String  `tfsdk:"use_case"`
+	AllowVersionUpdate   types.Bool    `tfsdk:"allow_version_update"`
+	AllowVariableUpdate  types.Bool    `tfsdk:"allow_variable_update"`
+	AllowDestroy         types.Bool    `tfsdk:"allow_destroy"`
+	InputVariables       types.Dynamic `tfsdk:"input_variables"`
+	CurrentConfig        types.Dynamic `tfsdk:"current_config"`
+	CatalogVersionPolicy types.Object  `tfsdk:"catalog_version_policy"`
+	VersionPolicy        types.Object  `tfsdk:"version_policy"`
 }
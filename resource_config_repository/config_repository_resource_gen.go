@@ -1,10 +1,18 @@
 // Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+//
+// Manual additions: sync_status and validate_connection attributes and the
+// SyncStatus and ValidateConnection fields were added by hand. The Schema()
+// method in provider/config_repository_resource.go overrides the generated
+// validate_connection entry with Optional+Computed+Default(false).
 
 package resource_config_repository
 
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"regexp"
@@ -62,6 +70,36 @@ func ConfigRepositoryResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z0-9]+[a-z0-9\\-_]+[a-z0-9]+$"), ""),
 				},
 			},
+			"sync_status": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"last_error": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The last error reported while pushing configuration to the repository.",
+						MarkdownDescription: "The last error reported while pushing configuration to the repository.",
+					},
+					"oldest_pending_at": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Unix timestamp of the oldest configuration change not yet pushed to the repository.",
+						MarkdownDescription: "Unix timestamp of the oldest configuration change not yet pushed to the repository.",
+					},
+					"pending_count": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "Number of configuration changes not yet pushed to the repository.",
+						MarkdownDescription: "Number of configuration changes not yet pushed to the repository.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						Description:         "Synchronization state of the repository: synced, syncing or error.",
+						MarkdownDescription: "Synchronization state of the repository: `synced`, `syncing` or `error`.",
+					},
+				},
+				Computed:            true,
+				Description:         "Synchronization status of the configuration pushed by Cycloid to the repository, as of the last refresh.",
+				MarkdownDescription: "Synchronization status of the configuration pushed by Cycloid to the repository, as of the last refresh.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"url": schema.StringAttribute{
 				Required:            true,
 				Description:         "Git URL of the config repository. SSH and HTTPS formats are accepted.",
@@ -70,6 +108,11 @@ func ConfigRepositoryResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^((/|~)[^/]*)+.(\\.git)|(([\\w\\]+@[\\w\\.]+))(:(//)?)([\\w\\.@\\:/\\-~]+)(/)?"), ""),
 				},
 			},
+			"validate_connection": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "When true, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists.",
+				MarkdownDescription: "When `true`, checks at plan and apply time that Cycloid can reach the repository with the credential and that the branch exists.",
+			},
 		},
 	}
 }
@@ -81,5 +124,17 @@ type ConfigRepositoryModel struct {
 	Default               types.Bool   `tfsdk:"default"`
 	Name                  types.String `tfsdk:"name"`
 	OrganizationCanonical types.String `tfsdk:"organization_canonical"`
+	SyncStatus            types.Object `tfsdk:"sync_status"`
 	Url                   types.String `tfsdk:"url"`
+	ValidateConnection    types.Bool   `tfsdk:"validate_connection"`
+}
+
+// SyncStatusAttrTypes returns the attribute types of the sync_status object.
+func SyncStatusAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"last_error":        types.StringType,
+		"oldest_pending_at": types.Int64Type,
+		"pending_count":     types.Int64Type,
+		"state":             types.StringType,
+	}
 }
@@ -1,4 +1,8 @@
 // Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+//
+// Manual additions: body_version and the write-only *_wo attributes, with
+// their model fields, were added by hand because the generator does not
+// support write-only attributes.
 
 package resource_credential
 
@@ -130,6 +134,11 @@ func CredentialResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The credential values, use the fields related to the credential `type`.",
 				MarkdownDescription: "The credential values, use the fields related to the credential `type`.",
 			},
+			"body_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "An arbitrary version of the write-only secrets. Terraform cannot detect a change of a write-only attribute, change this value to send the current write-only secrets to the API.",
+				MarkdownDescription: "An arbitrary version of the write-only secrets. Terraform cannot detect a change of a write-only attribute, change this value to send the current write-only secrets to the API.",
+			},
 			"canonical": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -140,12 +149,26 @@ func CredentialResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z0-9]+[a-z0-9\\-_]+[a-z0-9]+$"), ""),
 				},
 			},
+			"client_secret_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "Write-only counterpart of body.client_secret. The value is sent to the API but never stored in the Terraform state, bump body_version to send a new value. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only counterpart of `body.client_secret`. The value is sent to the API but never stored in the Terraform state, bump `body_version` to send a new value. Requires Terraform 1.11 or later.",
+			},
 			"description": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The description of the credential.",
 				MarkdownDescription: "The description of the credential.",
 			},
+			"json_key_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "Write-only counterpart of body.json_key. The value is sent to the API but never stored in the Terraform state, bump body_version to send a new value. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only counterpart of `body.json_key`. The value is sent to the API but never stored in the Terraform state, bump `body_version` to send a new value. Requires Terraform 1.11 or later.",
+			},
 			"name": schema.StringAttribute{
 				Required:            true,
 				Description:         "The name displayed in the UI of the credential.",
@@ -167,6 +190,13 @@ func CredentialResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "User canonical that owns this credential. If omitted then the person creating this\ncredential will be assigned as owner. When a user is the owner of a credential he has\nall the permissions on it.\n",
 				MarkdownDescription: "User canonical that owns this credential. If omitted then the person creating this\ncredential will be assigned as owner. When a user is the owner of a credential he has\nall the permissions on it.\n",
 			},
+			"password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "Write-only counterpart of body.password. The value is sent to the API but never stored in the Terraform state, bump body_version to send a new value. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only counterpart of `body.password`. The value is sent to the API but never stored in the Terraform state, bump `body_version` to send a new value. Requires Terraform 1.11 or later.",
+			},
 			"path": schema.StringAttribute{
 				Required:            true,
 				Description:         "The credential path written in vault and use for `pipelines`.",
@@ -175,6 +205,28 @@ func CredentialResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("[a-zA-z0-9_\\-./]"), ""),
 				},
 			},
+			"raw_wo": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "Write-only counterpart of body.raw, for type custom. The value is sent to the API but never stored in the Terraform state, bump body_version to send a new value. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only counterpart of `body.raw`, for type `custom`. The value is sent to the API but never stored in the Terraform state, bump `body_version` to send a new value. Requires Terraform 1.11 or later.",
+			},
+			"secret_key_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "Write-only counterpart of body.secret_key. The value is sent to the API but never stored in the Terraform state, bump body_version to send a new value. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only counterpart of `body.secret_key`. The value is sent to the API but never stored in the Terraform state, bump `body_version` to send a new value. Requires Terraform 1.11 or later.",
+			},
+			"ssh_key_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "Write-only counterpart of body.ssh_key. The value is sent to the API but never stored in the Terraform state, bump body_version to send a new value. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only counterpart of `body.ssh_key`. The value is sent to the API but never stored in the Terraform state, bump `body_version` to send a new value. Requires Terraform 1.11 or later.",
+			},
 			"type": schema.StringAttribute{
 				Required:            true,
 				Description:         "The type of the credential, see [the docs](https://docs.cycloid.io/reference/credentials/concepts).",
@@ -200,12 +252,19 @@ func CredentialResourceSchema(ctx context.Context) schema.Schema {
 
 type CredentialModel struct {
 	Body                  BodyValue    `tfsdk:"body"`
+	BodyVersion           types.Int64  `tfsdk:"body_version"`
 	Canonical             types.String `tfsdk:"canonical"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
 	Description           types.String `tfsdk:"description"`
+	JsonKeyWo             types.String `tfsdk:"json_key_wo"`
 	Name                  types.String `tfsdk:"name"`
 	OrganizationCanonical types.String `tfsdk:"organization_canonical"`
 	Owner                 types.String `tfsdk:"owner"`
+	PasswordWo            types.String `tfsdk:"password_wo"`
 	Path                  types.String `tfsdk:"path"`
+	RawWo                 types.Map    `tfsdk:"raw_wo"`
+	SecretKeyWo           types.String `tfsdk:"secret_key_wo"`
+	SshKeyWo              types.String `tfsdk:"ssh_key_wo"`
 	Type                  types.String `tfsdk:"type"`
 }
 
@@ -0,0 +1,265 @@
+package resource_credential_rotation
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var canonicalRegex = regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`)
+
+var durationRegex = regexp.MustCompile(`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`)
+
+func CredentialRotationResourceSchema(ctx context.Context) schema.Schema {
+	desc := strings.Join([]string{
+		"Rotates a credential by creating a new version of it instead of editing it in place.",
+		"On creation the source credential is the version 0 and nothing is rotated. Whenever `rotation_trigger` changes, a new credential holding `body` is created, named after `source_credential_canonical` with a `-v<version>` suffix, and at the same path with the same suffix.",
+		"The config repositories, catalog repositories, cloud accounts and external backends using the previous credential are then pointed to the new one. The ones managed by Terraform must reference `credential_canonical` of this resource, otherwise their next apply points them back to the credential of their configuration.",
+		"When `wait_for_pipeline` is set, the previous credential is kept until a build of that job started after the rotation succeeds; if the build fails, the dependents are pointed back to the previous credential. The previous version is deleted last, the source credential is always kept as it is usually managed by a `cycloid_credential` resource.",
+		"The secrets can be given through the write-only `*_wo` attributes instead of `body`, so that they are never stored in the Terraform state.",
+		"A rotation interrupted by an error is resumed by the next apply.",
+		"Destroying this resource deletes the versions it created, except the ones still in use, usually the current one, which are kept and reported as a warning.",
+	}, "\n")
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "Organization canonical of the credential. Defaults to provider `default_organization`.",
+				MarkdownDescription: "Organization canonical of the credential. Defaults to provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"source_credential_canonical": schema.StringAttribute{
+				Description:         "The canonical of the credential to rotate. Its dependents are pointed to the first version on the first rotation, the credential itself is kept.",
+				MarkdownDescription: "The canonical of the credential to rotate. Its dependents are pointed to the first version on the first rotation, the credential itself is kept.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(canonicalRegex, ""),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Description:         "An arbitrary value, like a date or the ID of the new cloud key, whose change rotates the credential. Changing only body or body_version updates the current credential in place, or only records the new body until the first rotation.",
+				MarkdownDescription: "An arbitrary value, like a date or the ID of the new cloud key, whose change rotates the credential. Changing only `body` or `body_version` updates the current credential in place, or only records the new body until the first rotation.",
+				Required:            true,
+			},
+			"body": schema.SingleNestedAttribute{
+				Description:         "The values of the new credential, use the fields related to the type of the source credential.",
+				MarkdownDescription: "The values of the new credential, use the fields related to the type of the source credential.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Object{
+					objectvalidator.AtLeastOneOf(
+						path.MatchRoot("client_secret_wo"),
+						path.MatchRoot("json_key_wo"),
+						path.MatchRoot("password_wo"),
+						path.MatchRoot("raw_wo"),
+						path.MatchRoot("secret_key_wo"),
+						path.MatchRoot("ssh_key_wo"),
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"access_key":      bodyStringAttribute("For type `aws` or `azure_storage`."),
+					"account_name":    bodyStringAttribute("For type `azure_storage`."),
+					"auth_url":        bodyStringAttribute("For type `swift`."),
+					"ca_cert":         bodyStringAttribute("For type `elasticsearch`."),
+					"client_id":       bodyStringAttribute("For type `azure`."),
+					"client_secret":   bodyStringAttribute("For type `azure`."),
+					"domain_id":       bodyStringAttribute("For type `swift`."),
+					"environment":     bodyStringAttribute("For type `azure` or `azure_storage`."),
+					"json_key":        bodyStringAttribute("For type `gcp`."),
+					"password":        bodyStringAttribute("For type `basic_auth`, `elasticsearch`, `swift` or `vmware`."),
+					"secret_key":      bodyStringAttribute("For type `aws`."),
+					"ssh_key":         bodyStringAttribute("For type `ssh`."),
+					"subscription_id": bodyStringAttribute("For type `azure`."),
+					"tenant_id":       bodyStringAttribute("For type `azure` or `swift`."),
+					"username":        bodyStringAttribute("For type `basic_auth`, `elasticsearch`, `swift` or `vmware`."),
+					"raw": schema.MapAttribute{
+						Description:         "For type `custom`.",
+						MarkdownDescription: "For type `custom`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"body_version": schema.Int64Attribute{
+				Description:         "An arbitrary version of the write-only secrets. Terraform cannot detect a change of a write-only attribute, change this value to send the current write-only secrets to the current credential in place, or change rotation_trigger to rotate with them.",
+				MarkdownDescription: "An arbitrary version of the write-only secrets. Terraform cannot detect a change of a write-only attribute, change this value to send the current write-only secrets to the current credential in place, or change `rotation_trigger` to rotate with them.",
+				Optional:            true,
+			},
+			"client_secret_wo": writeOnlyStringAttribute("body.client_secret"),
+			"json_key_wo":      writeOnlyStringAttribute("body.json_key"),
+			"password_wo":      writeOnlyStringAttribute("body.password"),
+			"raw_wo": schema.MapAttribute{
+				Description:         "Write-only counterpart of body.raw, for type custom. The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only counterpart of `body.raw`, for type `custom`. The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+			},
+			"secret_key_wo": writeOnlyStringAttribute("body.secret_key"),
+			"ssh_key_wo":    writeOnlyStringAttribute("body.ssh_key"),
+			"wait_for_pipeline": schema.SingleNestedAttribute{
+				Description:         "A pipeline job that must build successfully with the new credential before the previous one is deleted. The job is not triggered, only a build started after the rotation is awaited. When the build fails or none succeeds in time, the dependents are pointed back to the previous credential.",
+				MarkdownDescription: "A pipeline job that must build successfully with the new credential before the previous one is deleted. The job is not triggered, only a build started after the rotation is awaited. When the build fails or none succeeds in time, the dependents are pointed back to the previous credential.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"project": schema.StringAttribute{
+						Description:         "The canonical of the project of the pipeline.",
+						MarkdownDescription: "The canonical of the project of the pipeline.",
+						Required:            true,
+					},
+					"environment": schema.StringAttribute{
+						Description:         "The canonical of the environment of the pipeline.",
+						MarkdownDescription: "The canonical of the environment of the pipeline.",
+						Required:            true,
+					},
+					"component": schema.StringAttribute{
+						Description:         "The canonical of the component of the pipeline.",
+						MarkdownDescription: "The canonical of the component of the pipeline.",
+						Required:            true,
+					},
+					"pipeline": schema.StringAttribute{
+						Description:         "The name of the pipeline.",
+						MarkdownDescription: "The name of the pipeline.",
+						Required:            true,
+					},
+					"job": schema.StringAttribute{
+						Description:         "The name of the job whose build is awaited.",
+						MarkdownDescription: "The name of the job whose build is awaited.",
+						Required:            true,
+					},
+					"timeout": schema.StringAttribute{
+						Description:         "How long to wait for a successful build, like 30m or 1h30m. Defaults to 30m.",
+						MarkdownDescription: "How long to wait for a successful build, like `30m` or `1h30m`. Defaults to `30m`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(durationRegex, "must be a duration like 30m or 1h30m"),
+						},
+					},
+				},
+			},
+			"version": schema.Int64Attribute{
+				Description:         "The version of the current credential, 0 before the first rotation and incremented by each rotation.",
+				MarkdownDescription: "The version of the current credential, 0 before the first rotation and incremented by each rotation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"credential_canonical": schema.StringAttribute{
+				Description:         "The canonical of the current credential.",
+				MarkdownDescription: "The canonical of the current credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description:         "The path of the current credential.",
+				MarkdownDescription: "The path of the current credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dependents": schema.ListAttribute{
+				Description:         "The objects using the current credential.",
+				MarkdownDescription: "The objects using the current credential.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func bodyStringAttribute(desc string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Optional:            true,
+	}
+}
+
+// writeOnlyStringAttribute returns the write-only counterpart of the body
+// field.
+func writeOnlyStringAttribute(field string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         "Write-only counterpart of " + field + ". The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+		MarkdownDescription: "Write-only counterpart of `" + field + "`. The value is sent to the API but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+	}
+}
+
+type CredentialRotationModel struct {
+	Organization              types.String `tfsdk:"organization"`
+	SourceCredentialCanonical types.String `tfsdk:"source_credential_canonical"`
+	RotationTrigger           types.String `tfsdk:"rotation_trigger"`
+	Body                      types.Object `tfsdk:"body"`
+	BodyVersion               types.Int64  `tfsdk:"body_version"`
+	ClientSecretWo            types.String `tfsdk:"client_secret_wo"`
+	JsonKeyWo                 types.String `tfsdk:"json_key_wo"`
+	PasswordWo                types.String `tfsdk:"password_wo"`
+	RawWo                     types.Map    `tfsdk:"raw_wo"`
+	SecretKeyWo               types.String `tfsdk:"secret_key_wo"`
+	SshKeyWo                  types.String `tfsdk:"ssh_key_wo"`
+	WaitForPipeline           types.Object `tfsdk:"wait_for_pipeline"`
+	Version                   types.Int64  `tfsdk:"version"`
+	CredentialCanonical       types.String `tfsdk:"credential_canonical"`
+	Path                      types.String `tfsdk:"path"`
+	Dependents                types.List   `tfsdk:"dependents"`
+}
+
+type BodyModel struct {
+	AccessKey      types.String `tfsdk:"access_key"`
+	AccountName    types.String `tfsdk:"account_name"`
+	AuthUrl        types.String `tfsdk:"auth_url"`
+	CaCert         types.String `tfsdk:"ca_cert"`
+	ClientId       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	Environment    types.String `tfsdk:"environment"`
+	JsonKey        types.String `tfsdk:"json_key"`
+	Password       types.String `tfsdk:"password"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	SshKey         types.String `tfsdk:"ssh_key"`
+	SubscriptionId types.String `tfsdk:"subscription_id"`
+	TenantId       types.String `tfsdk:"tenant_id"`
+	Username       types.String `tfsdk:"username"`
+	Raw            types.Map    `tfsdk:"raw"`
+}
+
+type WaitForPipelineModel struct {
+	Project     types.String `tfsdk:"project"`
+	Environment types.String `tfsdk:"environment"`
+	Component   types.String `tfsdk:"component"`
+	Pipeline    types.String `tfsdk:"pipeline"`
+	Job         types.String `tfsdk:"job"`
+	Timeout     types.String `tfsdk:"timeout"`
+}
@@ -0,0 +1,186 @@
+package resource_external_backend
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Engines are the engines an external backend can have, each one has a
+// block of the same name.
+var Engines = []string{
+	"aws_cloud_watch_logs",
+	"aws_remote_tf_state",
+	"aws_storage",
+	"azure_cost_export",
+	"azure_remote_tf_state",
+	"azure_storage",
+	"elasticsearch_logs",
+	"gcp_cost_storage",
+	"gcp_remote_tf_state",
+	"gcp_storage",
+	"gitlab_http_storage",
+	"http_storage",
+	"swift_remote_tf_state",
+	"swift_storage",
+	"vmware_vsphere",
+}
+
+// Purposes are the purposes an external backend can have.
+var Purposes = []string{"events", "logs", "remote_tfstate", "cost_explorer"}
+
+// TestConnectionPurposes are the purposes the connection of an external
+// backend can be tested for.
+var TestConnectionPurposes = []string{"logs", "remote_tfstate", "cost_explorer"}
+
+// otherEngineBlocks returns the paths of the engine blocks but 'engine', for
+// the validator requiring exactly one engine block.
+func otherEngineBlocks(engine string) []path.Expression {
+	var exprs []path.Expression
+	for _, e := range Engines {
+		if e != engine {
+			exprs = append(exprs, path.MatchRoot(e))
+		}
+	}
+	return exprs
+}
+
+type AwsCloudWatchLogsModel struct {
+	Region types.String `tfsdk:"region"`
+}
+
+func AwsCloudWatchLogsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"region": types.StringType,
+	}
+}
+
+type AwsRemoteTfStateModel struct {
+	Bucket           types.String `tfsdk:"bucket"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	Key              types.String `tfsdk:"key"`
+	Region           types.String `tfsdk:"region"`
+	S3ForcePathStyle types.Bool   `tfsdk:"s3_force_path_style"`
+	SkipVerifySsl    types.Bool   `tfsdk:"skip_verify_ssl"`
+}
+
+func AwsRemoteTfStateAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"bucket":              types.StringType,
+		"endpoint":            types.StringType,
+		"key":                 types.StringType,
+		"region":              types.StringType,
+		"s3_force_path_style": types.BoolType,
+		"skip_verify_ssl":     types.BoolType,
+	}
+}
+
+type AzureCostExportModel struct {
+	BlobServiceUrl types.String `tfsdk:"blob_service_url"`
+	Name           types.String `tfsdk:"name"`
+	Scope          types.String `tfsdk:"scope"`
+}
+
+func AzureCostExportAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"blob_service_url": types.StringType,
+		"name":             types.StringType,
+		"scope":            types.StringType,
+	}
+}
+
+// AzureStorageModel is the model of both azure_storage and
+// azure_remote_tf_state.
+type AzureStorageModel struct {
+	Blob      types.String `tfsdk:"blob"`
+	Container types.String `tfsdk:"container"`
+	Endpoint  types.String `tfsdk:"endpoint"`
+}
+
+func AzureStorageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"blob":      types.StringType,
+		"container": types.StringType,
+		"endpoint":  types.StringType,
+	}
+}
+
+type ElasticsearchLogsModel struct {
+	Sources types.String `tfsdk:"sources"`
+	Urls    types.List   `tfsdk:"urls"`
+	Version types.String `tfsdk:"version"`
+}
+
+func ElasticsearchLogsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sources": types.StringType,
+		"urls":    types.ListType{ElemType: types.StringType},
+		"version": types.StringType,
+	}
+}
+
+type GcpCostStorageModel struct {
+	Dataset   types.String `tfsdk:"dataset"`
+	ProjectId types.String `tfsdk:"project_id"`
+	Table     types.String `tfsdk:"table"`
+}
+
+func GcpCostStorageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"dataset":    types.StringType,
+		"project_id": types.StringType,
+		"table":      types.StringType,
+	}
+}
+
+type GcpRemoteTfStateModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Object types.String `tfsdk:"object"`
+}
+
+func GcpRemoteTfStateAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"bucket": types.StringType,
+		"object": types.StringType,
+	}
+}
+
+// HttpStorageModel is the model of both http_storage and
+// gitlab_http_storage.
+type HttpStorageModel struct {
+	Url types.String `tfsdk:"url"`
+}
+
+func HttpStorageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url": types.StringType,
+	}
+}
+
+type SwiftRemoteTfStateModel struct {
+	Container     types.String `tfsdk:"container"`
+	Object        types.String `tfsdk:"object"`
+	Region        types.String `tfsdk:"region"`
+	SkipVerifySsl types.Bool   `tfsdk:"skip_verify_ssl"`
+}
+
+func SwiftRemoteTfStateAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"container":       types.StringType,
+		"object":          types.StringType,
+		"region":          types.StringType,
+		"skip_verify_ssl": types.BoolType,
+	}
+}
+
+type VmwareVsphereModel struct {
+	AllowUnverifiedSsl types.Bool   `tfsdk:"allow_unverified_ssl"`
+	Server             types.String `tfsdk:"server"`
+}
+
+func VmwareVsphereAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"allow_unverified_ssl": types.BoolType,
+		"server":               types.StringType,
+	}
+}
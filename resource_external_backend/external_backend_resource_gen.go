@@ -1,10 +1,15 @@
 // Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+//
+// Manual additions: the engine blocks other than aws_storage, gcp_storage and
+// swift_storage, test_connection, the engine and purpose validators and the
+// engine plan modifier were added by hand, see external_backend_engines.go.
 
 package resource_external_backend
 
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,11 +21,66 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
 
 func ExternalBackendResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"aws_cloud_watch_logs": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Required:            true,
+						Description:         "The AWS region of the CloudWatch Logs\n",
+						MarkdownDescription: "The AWS region of the CloudWatch Logs\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of AWS CloudWatch Logs for external backend.\nMust be matched with a credential of the \"aws\" type.\n",
+				MarkdownDescription: "Representation of AWS CloudWatch Logs for external backend.\nMust be matched with a credential of the \"aws\" type.\n",
+			},
+			"aws_remote_tf_state": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Required:            true,
+						Description:         "The AWS bucket containing objects\n",
+						MarkdownDescription: "The AWS bucket containing objects\n",
+					},
+					"endpoint": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "A custom endpoint for the S3 API (default: s3.amazonaws.com)\n",
+						MarkdownDescription: "A custom endpoint for the S3 API (default: s3.amazonaws.com)\n",
+					},
+					"key": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The S3 Key uniquely identifies an object in a bucket\n",
+						MarkdownDescription: "The S3 Key uniquely identifies an object in a bucket\n",
+					},
+					"region": schema.StringAttribute{
+						Required:            true,
+						Description:         "The AWS region where the resource exists\n",
+						MarkdownDescription: "The AWS region where the resource exists\n",
+					},
+					"s3_force_path_style": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Always use path-style S3 URLs (https://<HOST>/<BUCKET> instead of https://<BUCKET>.<HOST>)\n",
+						MarkdownDescription: "Always use path-style S3 URLs (https://<HOST>/<BUCKET> instead of https://<BUCKET>.<HOST>)\n",
+					},
+					"skip_verify_ssl": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Set this to `true` to not verify SSL certificates\n",
+						MarkdownDescription: "Set this to `true` to not verify SSL certificates\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of AWS remote tf state for external backend.\nMust be matched with a credential of the \"aws\" type.\n",
+				MarkdownDescription: "Representation of AWS remote tf state for external backend.\nMust be matched with a credential of the \"aws\" type.\n",
+			},
 			"aws_storage": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"bucket": schema.StringAttribute{
@@ -69,6 +129,82 @@ func ExternalBackendResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "Representation of AWS storage for external backend.\nMust be matched with a credential of the \"aws\" type.\n",
 				MarkdownDescription: "Representation of AWS storage for external backend.\nMust be matched with a credential of the \"aws\" type.\n",
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(otherEngineBlocks("aws_storage")...),
+				},
+			},
+			"azure_cost_export": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"blob_service_url": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The URL of the blob service the costs are exported to\n",
+						MarkdownDescription: "The URL of the blob service the costs are exported to\n",
+					},
+					"name": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The name of the cost export\n",
+						MarkdownDescription: "The name of the cost export\n",
+					},
+					"scope": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The scope of the cost export, like a subscription\n",
+						MarkdownDescription: "The scope of the cost export, like a subscription\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of an Azure cost export for external backend.\nMust be matched with a credential of the \"azure\" type.\n",
+				MarkdownDescription: "Representation of an Azure cost export for external backend.\nMust be matched with a credential of the \"azure\" type.\n",
+			},
+			"azure_remote_tf_state": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"blob": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The Azure blob contained in the container\n",
+						MarkdownDescription: "The Azure blob contained in the container\n",
+					},
+					"container": schema.StringAttribute{
+						Required:            true,
+						Description:         "The Azure container where the resource exists\n",
+						MarkdownDescription: "The Azure container where the resource exists\n",
+					},
+					"endpoint": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "A custom endpoint for the Azure API\n",
+						MarkdownDescription: "A custom endpoint for the Azure API\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of Azure remote tf state for external backend.\nMust be matched with a credential of the \"azure_storage\" type.\n",
+				MarkdownDescription: "Representation of Azure remote tf state for external backend.\nMust be matched with a credential of the \"azure_storage\" type.\n",
+			},
+			"azure_storage": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"blob": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The Azure blob contained in the container\n",
+						MarkdownDescription: "The Azure blob contained in the container\n",
+					},
+					"container": schema.StringAttribute{
+						Required:            true,
+						Description:         "The Azure container where the resource exists\n",
+						MarkdownDescription: "The Azure container where the resource exists\n",
+					},
+					"endpoint": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "A custom endpoint for the Azure API\n",
+						MarkdownDescription: "A custom endpoint for the Azure API\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of Azure storage for external backend.\nMust be matched with a credential of the \"azure_storage\" type.\n",
+				MarkdownDescription: "Representation of Azure storage for external backend.\nMust be matched with a credential of the \"azure_storage\" type.\n",
 			},
 			"credential_canonical": schema.StringAttribute{
 				Optional:            true,
@@ -86,14 +222,39 @@ func ExternalBackendResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Whether this is the default external backend for the organization.",
 				MarkdownDescription: "Whether this is the default external backend for the organization.",
 			},
+			"elasticsearch_logs": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"sources": schema.StringAttribute{
+						Required:            true,
+						Description:         "The sources of the logs, JSON encoded: a map of source names to a map of\nenvironments to their index, mapping, prefilters and urls. Use jsonencode() to set it.\n",
+						MarkdownDescription: "The sources of the logs, JSON encoded: a map of source names to a map of\nenvironments to their index, mapping, prefilters and urls. Use jsonencode() to set it.\n",
+					},
+					"urls": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Required:            true,
+						Description:         "The URLs of the Elasticsearch servers\n",
+						MarkdownDescription: "The URLs of the Elasticsearch servers\n",
+					},
+					"version": schema.StringAttribute{
+						Required:            true,
+						Description:         "The version of the Elasticsearch servers\n",
+						MarkdownDescription: "The version of the Elasticsearch servers\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of Elasticsearch logs for external backend.\nMust be matched with a credential of the \"elasticsearch\" type.\n",
+				MarkdownDescription: "Representation of Elasticsearch logs for external backend.\nMust be matched with a credential of the \"elasticsearch\" type.\n",
+			},
 			"engine": schema.StringAttribute{
-				Required: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The engine of the external backend, defaults to the one of the engine block set.",
+				MarkdownDescription: "The engine of the external backend, defaults to the one of the engine block set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"aws_storage",
-						"gcp_storage",
-						"swift_storage",
-					),
+					stringvalidator.OneOf(Engines...),
 				},
 			},
 			"environment_canonical": schema.StringAttribute{
@@ -112,6 +273,49 @@ func ExternalBackendResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "External Backend ID",
 				MarkdownDescription: "External Backend ID",
 			},
+			"gcp_cost_storage": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"dataset": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The BigQuery dataset containing the billing export\n",
+						MarkdownDescription: "The BigQuery dataset containing the billing export\n",
+					},
+					"project_id": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The GCP project of the BigQuery dataset\n",
+						MarkdownDescription: "The GCP project of the BigQuery dataset\n",
+					},
+					"table": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The BigQuery table containing the billing export\n",
+						MarkdownDescription: "The BigQuery table containing the billing export\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of a GCP BigQuery billing export for external backend.\nMust be matched with a credential of the \"gcp\" type.\n",
+				MarkdownDescription: "Representation of a GCP BigQuery billing export for external backend.\nMust be matched with a credential of the \"gcp\" type.\n",
+			},
+			"gcp_remote_tf_state": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Required:            true,
+						Description:         "The GCP bucket containing objects\n",
+						MarkdownDescription: "The GCP bucket containing objects\n",
+					},
+					"object": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The GCP object uniquely identifying an object in a bucket,\nwill be required if the EB is not default\n",
+						MarkdownDescription: "The GCP object uniquely identifying an object in a bucket,\nwill be required if the EB is not default\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of GCP remote tf state for external backend.\nMust be matched with a credential of the \"gcp\" type.\n",
+				MarkdownDescription: "Representation of GCP remote tf state for external backend.\nMust be matched with a credential of the \"gcp\" type.\n",
+			},
 			"gcp_storage": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"bucket": schema.StringAttribute{
@@ -137,6 +341,30 @@ func ExternalBackendResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Representation of GCP remote tf state for external backend.\nMust be matched with a credential of the \"gcp\" type.\n",
 				MarkdownDescription: "Representation of GCP remote tf state for external backend.\nMust be matched with a credential of the \"gcp\" type.\n",
 			},
+			"gitlab_http_storage": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Required:            true,
+						Description:         "The URL of the GitLab Terraform state\n",
+						MarkdownDescription: "The URL of the GitLab Terraform state\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of GitLab HTTP storage for external backend.\nMust be matched with a credential of the \"basic_auth\" type.\n",
+				MarkdownDescription: "Representation of GitLab HTTP storage for external backend.\nMust be matched with a credential of the \"basic_auth\" type.\n",
+			},
+			"http_storage": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Required:            true,
+						Description:         "The URL of the HTTP storage\n",
+						MarkdownDescription: "The URL of the HTTP storage\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of HTTP storage for external backend.\nMust be matched with a credential of the \"basic_auth\" type.\n",
+				MarkdownDescription: "Representation of HTTP storage for external backend.\nMust be matched with a credential of the \"basic_auth\" type.\n",
+			},
 			"organization_canonical": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -160,11 +388,38 @@ func ExternalBackendResourceSchema(ctx context.Context) schema.Schema {
 			"purpose": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"remote_tfstate",
-					),
+					stringvalidator.OneOf(Purposes...),
 				},
 			},
+			"swift_remote_tf_state": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"container": schema.StringAttribute{
+						Required:            true,
+						Description:         "The Swift container containing objects\n",
+						MarkdownDescription: "The Swift container containing objects\n",
+					},
+					"object": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The swift object uniquely identifying an object in a container,\nwill be required if the EB is not default\n",
+						MarkdownDescription: "The swift object uniquely identifying an object in a container,\nwill be required if the EB is not default\n",
+					},
+					"region": schema.StringAttribute{
+						Required:            true,
+						Description:         "The Swift region where the resource exists\n",
+						MarkdownDescription: "The Swift region where the resource exists\n",
+					},
+					"skip_verify_ssl": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Set this to `true` to not verify SSL certificates\n",
+						MarkdownDescription: "Set this to `true` to not verify SSL certificates\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of Swift remote tf state for external backend.\nMust be matched with a credential of the \"swift\" type.\n",
+				MarkdownDescription: "Representation of Swift remote tf state for external backend.\nMust be matched with a credential of the \"swift\" type.\n",
+			},
 			"swift_storage": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"container": schema.StringAttribute{
@@ -202,22 +457,59 @@ func ExternalBackendResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Representation of Swift remote tf state for external backend.\nMust be matched with a credential of the \"swift\" type.\n",
 				MarkdownDescription: "Representation of Swift remote tf state for external backend.\nMust be matched with a credential of the \"swift\" type.\n",
 			},
+			"test_connection": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "When true, the configuration is tested from the Cycloid API with the credential before the external backend is created or updated, failing the apply when the API cannot reach it. Only supported for the logs, remote_tfstate and cost_explorer purposes.",
+				MarkdownDescription: "When `true`, the configuration is tested from the Cycloid API with the credential before the external backend is created or updated, failing the apply when the API cannot reach it. Only supported for the `logs`, `remote_tfstate` and `cost_explorer` purposes.",
+			},
+			"vmware_vsphere": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"allow_unverified_ssl": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Set this to `true` to not verify SSL certificates\n",
+						MarkdownDescription: "Set this to `true` to not verify SSL certificates\n",
+					},
+					"server": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The address of the vSphere server\n",
+						MarkdownDescription: "The address of the vSphere server\n",
+					},
+				},
+				Optional:            true,
+				Description:         "Representation of a VMware vSphere server for external backend.\nMust be matched with a credential of the \"vmware\" type.\n",
+				MarkdownDescription: "Representation of a VMware vSphere server for external backend.\nMust be matched with a credential of the \"vmware\" type.\n",
+			},
 		},
 	}
 }
 
 type ExternalBackendModel struct {
+	AwsCloudWatchLogs     types.Object      `tfsdk:"aws_cloud_watch_logs"`
+	AwsRemoteTfState      types.Object      `tfsdk:"aws_remote_tf_state"`
 	AwsStorage            AwsStorageValue   `tfsdk:"aws_storage"`
+	AzureCostExport       types.Object      `tfsdk:"azure_cost_export"`
+	AzureRemoteTfState    types.Object      `tfsdk:"azure_remote_tf_state"`
+	AzureStorage          types.Object      `tfsdk:"azure_storage"`
 	CredentialCanonical   types.String      `tfsdk:"credential_canonical"`
 	Default               types.Bool        `tfsdk:"default"`
+	ElasticsearchLogs     types.Object      `tfsdk:"elasticsearch_logs"`
 	Engine                types.String      `tfsdk:"engine"`
 	EnvironmentCanonical  types.String      `tfsdk:"environment_canonical"`
 	ExternalBackendId     types.Int64       `tfsdk:"external_backend_id"`
+	GcpCostStorage        types.Object      `tfsdk:"gcp_cost_storage"`
+	GcpRemoteTfState      types.Object      `tfsdk:"gcp_remote_tf_state"`
 	GcpStorage            GcpStorageValue   `tfsdk:"gcp_storage"`
+	GitlabHttpStorage     types.Object      `tfsdk:"gitlab_http_storage"`
+	HttpStorage           types.Object      `tfsdk:"http_storage"`
 	OrganizationCanonical types.String      `tfsdk:"organization_canonical"`
 	ProjectCanonical      types.String      `tfsdk:"project_canonical"`
 	Purpose               types.String      `tfsdk:"purpose"`
+	SwiftRemoteTfState    types.Object      `tfsdk:"swift_remote_tf_state"`
 	SwiftStorage          SwiftStorageValue `tfsdk:"swift_storage"`
+	TestConnection        types.Bool        `tfsdk:"test_connection"`
+	VmwareVsphere         types.Object      `tfsdk:"vmware_vsphere"`
 }
 
 var _ basetypes.ObjectTypable = AwsStorageType{}
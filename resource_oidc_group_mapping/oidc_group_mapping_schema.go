@@ -17,7 +17,7 @@ func OidcGroupMappingResourceSchema(ctx context.Context) schema.Schema {
 	desc := strings.Join([]string{
 		"Maps an OIDC group claim to a team within an organization.",
 		"The org-level role granted to OIDC-managed users is driven by the per-organization OIDC settings (`cycloid_oidc_organization_settings.default_role_canonical`), not by the mapping.",
-		"Assign a group to several teams by declaring multiple mappings.",
+		"Assign a group to several teams by declaring multiple mappings, or manage the full set of mappings with `cycloid_oidc_group_mappings`.",
 	}, "\n")
 
 	return schema.Schema{
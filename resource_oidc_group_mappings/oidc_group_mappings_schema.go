@@ -0,0 +1,55 @@
+package resource_oidc_group_mappings
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func OidcGroupMappingsResourceSchema(ctx context.Context) schema.Schema {
+	desc := strings.Join([]string{
+		"Manages the full set of OIDC group mappings of an organization.",
+		"The resource is authoritative: mappings created outside Terraform show up in the plan and are deleted on apply, the plan warns about them. Do not use it along with `cycloid_oidc_group_mapping` in the same organization, each apply of one deletes or creates back the mappings of the other.",
+		"Destroying the resource only deletes the mappings of its state, the ones created since the last refresh are kept.",
+		"The roles granted through a group are the roles of its teams; the org-level role of OIDC-managed users is driven by `cycloid_oidc_organization_settings.default_role_canonical`.",
+	}, "\n")
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "Organization canonical where to manage the mappings. Defaults to provider `default_organization`.",
+				MarkdownDescription: "Organization canonical where to manage the mappings. Defaults to provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`), ""),
+				},
+			},
+			"mappings": schema.MapAttribute{
+				Description:         "The canonicals of the teams a user is added to, by OIDC group claim value. An empty map removes every mapping of the organization.",
+				MarkdownDescription: "The canonicals of the teams a user is added to, by OIDC group claim value. An empty map removes every mapping of the organization.",
+				Required:            true,
+				ElementType:         types.SetType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+type OidcGroupMappingsModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Mappings     types.Map    `tfsdk:"mappings"`
+}
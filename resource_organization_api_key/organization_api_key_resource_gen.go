@@ -1,4 +1,8 @@
 // Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+//
+// Manual additions: rotation_triggers, rotate_after_days, current_canonical,
+// rotated_at, next_rotation_at and last_used, with their model fields, were
+// added by hand.
 
 package resource_organization_api_key
 
@@ -6,6 +10,7 @@ import (
 	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -93,11 +98,51 @@ func OrganizationAPIKeyResourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Arbitrary values whose change rotates the API key: a new key is created, then the previous one is revoked in the same apply, with no grace period. Update the consumers of the token right after the apply.",
+				MarkdownDescription: "Arbitrary values whose change rotates the API key: a new key is created, then the previous one is revoked in the same apply, with no grace period. Update the consumers of the `token` right after the apply.",
+			},
+			"rotate_after_days": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Rotates the API key on the first apply this number of days after it was created or last rotated.",
+				MarkdownDescription: "Rotates the API key on the first apply this number of days after it was created or last rotated.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"current_canonical": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The canonical of the API key holding the token. It is canonical until the first rotation, then canonical with a -v<version> suffix.",
+				MarkdownDescription: "The canonical of the API key holding the `token`. It is `canonical` until the first rotation, then `canonical` with a `-v<version>` suffix.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the token was created, in RFC 3339 format. For the API keys created before the provider tracked it, or imported, when it was first read: rotate_after_days then counts from the provider upgrade or the import, not from the creation of the key.",
+				MarkdownDescription: "When the `token` was created, in RFC 3339 format. For the API keys created before the provider tracked it, or imported, when it was first read: `rotate_after_days` then counts from the provider upgrade or the import, not from the creation of the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"next_rotation_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the API key is due for rotation according to rotate_after_days, in RFC 3339 format.",
+				MarkdownDescription: "When the API key is due for rotation according to `rotate_after_days`, in RFC 3339 format.",
+			},
+			"last_used": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the API key was last used, in RFC 3339 format. Null if it has never been used.",
+				MarkdownDescription: "When the API key was last used, in RFC 3339 format. Null if it has never been used.",
+			},
 			"token": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
-				Description:         "The JWT API key token. Only populated once, at creation time. Store it securely — it cannot be retrieved afterwards.",
-				MarkdownDescription: "The JWT API key token. Only populated once, at creation time. Store it securely — it cannot be retrieved afterwards.",
+				Description:         "The JWT API key token. Only populated at creation time and on rotation. Store it securely — it cannot be retrieved afterwards.",
+				MarkdownDescription: "The JWT API key token. Only populated at creation time and on rotation. Store it securely — it cannot be retrieved afterwards.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -131,6 +176,12 @@ type OrganizationAPIKeyModel struct {
 	Description           types.String `tfsdk:"description"`
 	Owner                 types.String `tfsdk:"owner"`
 	Rules                 types.List   `tfsdk:"rules"`
+	RotationTriggers      types.Map    `tfsdk:"rotation_triggers"`
+	RotateAfterDays       types.Int64  `tfsdk:"rotate_after_days"`
+	CurrentCanonical      types.String `tfsdk:"current_canonical"`
+	RotatedAt             types.String `tfsdk:"rotated_at"`
+	NextRotationAt        types.String `tfsdk:"next_rotation_at"`
+	LastUsed              types.String `tfsdk:"last_used"`
 	Token                 types.String `tfsdk:"token"`
 	LastSeven             types.String `tfsdk:"last_seven"`
 	ID                    types.Int64  `tfsdk:"id"`
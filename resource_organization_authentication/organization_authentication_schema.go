@@ -0,0 +1,242 @@
+package resource_organization_authentication
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Methods lists the login method blocks of the resource, local first.
+var Methods = []string{"local", "azure_ad", "github", "google", "saml"}
+
+// atLeastOneMethod requires at least one login method block to be set.
+func atLeastOneMethod() validator.Object {
+	var exprs []path.Expression
+	for _, m := range Methods {
+		exprs = append(exprs, path.MatchRoot(m))
+	}
+	return objectvalidator.AtLeastOneOf(exprs...)
+}
+
+func OrganizationAuthenticationResourceSchema(ctx context.Context) schema.Schema {
+	desc := strings.Join([]string{
+		"Manages the login methods of an organization: local (email and password) login and the Azure AD, GitHub, Google and SAML SSO integrations.",
+		"Only the methods with a block are managed, the others keep their current configuration. OIDC is managed by `cycloid_oidc_integration`.",
+		"Methods are updated via a PUT (merge semantics); absent keys keep their stored values. The enabled SSO methods are sent first, then the disabled ones, and local login last, so the organization keeps a login method during the apply.",
+		"There is no delete API endpoint — removing this resource disables the managed SSO methods, enables local login back and drops the resource from Terraform state.",
+	}, "\n")
+
+	enabled := schema.BoolAttribute{
+		Description:         "Whether users can log in with this method.",
+		MarkdownDescription: "Whether users can log in with this method.",
+		Required:            true,
+	}
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "Organization canonical where to manage the login methods. Defaults to provider `default_organization`.",
+				MarkdownDescription: "Organization canonical where to manage the login methods. Defaults to provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`), ""),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description:         "An arbitrary version of the write-only secrets. Terraform cannot detect a change of a write-only attribute, change this value to send the current write-only secrets to the API. The secrets are sent on creation and when a method is added, otherwise the stored secrets are kept.",
+				MarkdownDescription: "An arbitrary version of the write-only secrets. Terraform cannot detect a change of a write-only attribute, change this value to send the current write-only secrets to the API. The secrets are sent on creation and when a method is added, otherwise the stored secrets are kept.",
+				Optional:            true,
+			},
+			"local": schema.SingleNestedAttribute{
+				Description:         "Login with the email and password of the Cycloid account.",
+				MarkdownDescription: "Login with the email and password of the Cycloid account.",
+				Optional:            true,
+				Validators:          []validator.Object{atLeastOneMethod()},
+				Attributes: map[string]schema.Attribute{
+					"enabled": enabled,
+				},
+			},
+			"azure_ad": schema.SingleNestedAttribute{
+				Description:         "Single sign-on with Azure Active Directory.",
+				MarkdownDescription: "Single sign-on with Azure Active Directory.",
+				Optional:            true,
+				Validators:          []validator.Object{atLeastOneMethod()},
+				Attributes: map[string]schema.Attribute{
+					"enabled": enabled,
+					"client_id": schema.StringAttribute{
+						Description:         "ID of the application registered in Azure AD.",
+						MarkdownDescription: "ID of the application registered in Azure AD.",
+						Optional:            true,
+					},
+					"tenant_id": schema.StringAttribute{
+						Description:         "ID of the Azure AD tenant.",
+						MarkdownDescription: "ID of the Azure AD tenant.",
+						Optional:            true,
+					},
+				},
+			},
+			"github": schema.SingleNestedAttribute{
+				Description:         "Single sign-on with GitHub or GitHub Enterprise.",
+				MarkdownDescription: "Single sign-on with GitHub or GitHub Enterprise.",
+				Optional:            true,
+				Validators:          []validator.Object{atLeastOneMethod()},
+				Attributes: map[string]schema.Attribute{
+					"enabled": enabled,
+					"client_id": schema.StringAttribute{
+						Description:         "ID of the GitHub OAuth application.",
+						MarkdownDescription: "ID of the GitHub OAuth application.",
+						Optional:            true,
+					},
+					"host_address": schema.StringAttribute{
+						Description:         "Address of the GitHub API the tokens are requested from, https://api.github.com unless GitHub Enterprise is used.",
+						MarkdownDescription: "Address of the GitHub API the tokens are requested from, `https://api.github.com` unless GitHub Enterprise is used.",
+						Optional:            true,
+					},
+					"client_secret_wo": schema.StringAttribute{
+						Description:         "Secret of the GitHub OAuth application. The value is sent to the API but never stored in the Terraform state, bump secrets_version to send a new value. Requires Terraform 1.11 or later.",
+						MarkdownDescription: "Secret of the GitHub OAuth application. The value is sent to the API but never stored in the Terraform state, bump `secrets_version` to send a new value. Requires Terraform 1.11 or later.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"has_secret": schema.BoolAttribute{
+						Description:         "True when the server has a stored client secret.",
+						MarkdownDescription: "True when the server has a stored client secret.",
+						Computed:            true,
+					},
+				},
+			},
+			"google": schema.SingleNestedAttribute{
+				Description:         "Single sign-on with Google.",
+				MarkdownDescription: "Single sign-on with Google.",
+				Optional:            true,
+				Validators:          []validator.Object{atLeastOneMethod()},
+				Attributes: map[string]schema.Attribute{
+					"enabled": enabled,
+					"client_id": schema.StringAttribute{
+						Description:         "ID of the Google OAuth client.",
+						MarkdownDescription: "ID of the Google OAuth client.",
+						Optional:            true,
+					},
+				},
+			},
+			"saml": schema.SingleNestedAttribute{
+				Description:         "Single sign-on with a SAML2 identity provider.",
+				MarkdownDescription: "Single sign-on with a SAML2 identity provider.",
+				Optional:            true,
+				Validators:          []validator.Object{atLeastOneMethod()},
+				Attributes: map[string]schema.Attribute{
+					"enabled": enabled,
+					"provider": schema.StringAttribute{
+						Description:         "Entity ID of the SAML2 identity provider.",
+						MarkdownDescription: "Entity ID of the SAML2 identity provider.",
+						Optional:            true,
+					},
+					"sso_url": schema.StringAttribute{
+						Description:         "URL users are redirected to in order to authenticate with the identity provider.",
+						MarkdownDescription: "URL users are redirected to in order to authenticate with the identity provider.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// OrganizationAuthenticationModel is the Terraform state model for the
+// organization_authentication resource.
+type OrganizationAuthenticationModel struct {
+	Organization   types.String `tfsdk:"organization"`
+	SecretsVersion types.Int64  `tfsdk:"secrets_version"`
+	Local          types.Object `tfsdk:"local"`
+	AzureAD        types.Object `tfsdk:"azure_ad"`
+	GitHub         types.Object `tfsdk:"github"`
+	Google         types.Object `tfsdk:"google"`
+	SAML           types.Object `tfsdk:"saml"`
+}
+
+type LocalModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func LocalAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled": types.BoolType,
+	}
+}
+
+type AzureADModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	ClientID types.String `tfsdk:"client_id"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func AzureADAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":   types.BoolType,
+		"client_id": types.StringType,
+		"tenant_id": types.StringType,
+	}
+}
+
+type GitHubModel struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ClientID       types.String `tfsdk:"client_id"`
+	HostAddress    types.String `tfsdk:"host_address"`
+	ClientSecretWo types.String `tfsdk:"client_secret_wo"`
+	HasSecret      types.Bool   `tfsdk:"has_secret"`
+}
+
+func GitHubAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":          types.BoolType,
+		"client_id":        types.StringType,
+		"host_address":     types.StringType,
+		"client_secret_wo": types.StringType,
+		"has_secret":       types.BoolType,
+	}
+}
+
+type GoogleModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	ClientID types.String `tfsdk:"client_id"`
+}
+
+func GoogleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":   types.BoolType,
+		"client_id": types.StringType,
+	}
+}
+
+type SAMLModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Provider types.String `tfsdk:"provider"`
+	SsoURL   types.String `tfsdk:"sso_url"`
+}
+
+func SAMLAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":  types.BoolType,
+		"provider": types.StringType,
+		"sso_url":  types.StringType,
+	}
+}
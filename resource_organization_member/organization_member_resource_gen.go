@@ -1,10 +1,17 @@
 // Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+//
+// Manual additions: invitation_status, invitation_expires_at,
+// invitation_validity_days, invited_by and resend_expired_invitation, with
+// their model fields, were added by hand.
 
 package resource_organization_member
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"regexp"
@@ -26,6 +33,38 @@ func OrganizationMemberResourceSchema(ctx context.Context) schema.Schema {
 					),
 				},
 			},
+			"invitation_expires_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the pending invitation of the member expires, in RFC 3339 format. Null once the invitation is accepted or when invitation_validity_days is not set.",
+				MarkdownDescription: "When the pending invitation of the member expires, in RFC 3339 format. Null once the invitation is accepted or when `invitation_validity_days` is not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The status of the invitation of the member: pending, expired, accepted or declined. An invitation is only expired when invitation_validity_days is set. The API has no expired state: the status is derived at each refresh from the time the invitation was last sent, so it can turn to expired without any change in Cycloid.",
+				MarkdownDescription: "The status of the invitation of the member: `pending`, `expired`, `accepted` or `declined`. An invitation is only `expired` when `invitation_validity_days` is set. The API has no expired state: the status is derived at each refresh from the time the invitation was last sent, so it can turn to `expired` without any change in Cycloid.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_validity_days": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "How many days an invitation can be accepted after it was last sent. The API does not expose the expiry of the invitations, so it must match the validity period of your Cycloid instance. Required when resend_expired_invitation is true.",
+				MarkdownDescription: "How many days an invitation can be accepted after it was last sent. The API does not expose the expiry of the invitations, so it must match the validity period of your Cycloid instance. Required when `resend_expired_invitation` is `true`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"invited_by": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The username of the member who sent the invitation.",
+				MarkdownDescription: "The username of the member who sent the invitation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"member_canonical": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The canonical (username) of the member.",
@@ -47,6 +86,11 @@ func OrganizationMemberResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z0-9]+[a-z0-9\\-_]+[a-z0-9]+$"), ""),
 				},
 			},
+			"resend_expired_invitation": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "When true, an expired invitation is sent again on the next apply. The invitation is deleted and a new one is sent, so the member_id changes.",
+				MarkdownDescription: "When `true`, an expired invitation is sent again on the next apply. The invitation is deleted and a new one is sent, so the `member_id` changes.",
+			},
 			"role_canonical": schema.StringAttribute{
 				Required:            true,
 				Description:         "The canonical of the role assigned to the member.",
@@ -61,9 +105,14 @@ func OrganizationMemberResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type OrganizationMemberModel struct {
-	Email                 types.String `tfsdk:"email"`
-	MemberCanonical       types.String `tfsdk:"member_canonical"`
-	MemberId              types.Int64  `tfsdk:"member_id"`
-	OrganizationCanonical types.String `tfsdk:"organization_canonical"`
-	RoleCanonical         types.String `tfsdk:"role_canonical"`
+	Email                   types.String `tfsdk:"email"`
+	InvitationExpiresAt     types.String `tfsdk:"invitation_expires_at"`
+	InvitationStatus        types.String `tfsdk:"invitation_status"`
+	InvitationValidityDays  types.Int64  `tfsdk:"invitation_validity_days"`
+	InvitedBy               types.String `tfsdk:"invited_by"`
+	MemberCanonical         types.String `tfsdk:"member_canonical"`
+	MemberId                types.Int64  `tfsdk:"member_id"`
+	OrganizationCanonical   types.String `tfsdk:"organization_canonical"`
+	ResendExpiredInvitation types.Bool   `tfsdk:"resend_expired_invitation"`
+	RoleCanonical           types.String `tfsdk:"role_canonical"`
 }
@@ -0,0 +1,94 @@
+package resource_organization_naming_rules
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ruleAttribute returns the block of the naming rule of entities.
+func ruleAttribute(entities string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         "The naming rule of the " + entities + ". Without it, any canonical is accepted.",
+		MarkdownDescription: "The naming rule of the " + entities + ". Without it, any canonical is accepted.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"regex": schema.StringAttribute{
+				Description:         "Regex pattern the canonicals of the " + entities + " must match.",
+				MarkdownDescription: "Regex pattern the canonicals of the " + entities + " must match.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description:         "Custom error message shown when a canonical does not match the regex.",
+				MarkdownDescription: "Custom error message shown when a canonical does not match the `regex`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+		},
+	}
+}
+
+func OrganizationNamingRulesResourceSchema(ctx context.Context) schema.Schema {
+	desc := strings.Join([]string{
+		"Manages the naming rules of an organization: the patterns the canonicals of projects, environments and components must match.",
+		"The rules are enforced by the API, so they apply to the console and CLI users as well as to Terraform.",
+		"The rules are sent in the organization update, under the same lock of the provider as `cycloid_organization` and `cycloid_organization_security_settings`, which send the current rules back.",
+		"Removing this resource clears the naming rules of the organization.",
+	}, "\n")
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "Organization canonical where to manage the naming rules. Defaults to provider `default_organization`.",
+				MarkdownDescription: "Organization canonical where to manage the naming rules. Defaults to provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+[a-z0-9\-_]+[a-z0-9]+$`), ""),
+				},
+			},
+			"project":     ruleAttribute("projects"),
+			"environment": ruleAttribute("environments"),
+			"component":   ruleAttribute("components"),
+		},
+	}
+}
+
+type OrganizationNamingRulesModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.Object `tfsdk:"project"`
+	Environment  types.Object `tfsdk:"environment"`
+	Component    types.Object `tfsdk:"component"`
+}
+
+type RuleModel struct {
+	Regex   types.String `tfsdk:"regex"`
+	Message types.String `tfsdk:"message"`
+}
+
+func RuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"regex":   types.StringType,
+		"message": types.StringType,
+	}
+}
@@ -0,0 +1,128 @@
+package resource_team_members
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// ModeAuthoritative removes the members of the team which are not in
+	// the configuration.
+	ModeAuthoritative = "authoritative"
+	// ModeAdditive only adds the members of the configuration, leaving the
+	// others in the team.
+	ModeAdditive = "additive"
+)
+
+func TeamMembersResourceSchema(ctx context.Context) schema.Schema {
+	desc := strings.Join([]string{
+		"Manages the members of a team as a whole, by email or member ID.",
+		"In the `authoritative` mode, the default, the members added to the team outside of Terraform, like through the console or SSO, show up as drift and are removed on the next apply.",
+		"In the `additive` mode, they are left in the team, which suits teams shared with other tools or configurations.",
+		"Destroying this resource removes the members of the configuration from the team.",
+		"Do not use it together with `cycloid_team_member` on the same team.",
+	}, "\n")
+
+	return schema.Schema{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description:         "The organization canonical of the team, default to the provider `default_organization`.",
+				MarkdownDescription: "The organization canonical of the team, default to the provider `default_organization`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"team": schema.StringAttribute{
+				Description:         "The canonical of the team.",
+				MarkdownDescription: "The canonical of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emails": schema.SetAttribute{
+				Description:         "The emails of the members of the team.",
+				MarkdownDescription: "The emails of the members of the team.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"member_ids": schema.SetAttribute{
+				Description:         "The IDs of the members of the team. In the authoritative mode, the members of the team which are not in the configuration show up here.",
+				MarkdownDescription: "The IDs of the members of the team. In the `authoritative` mode, the members of the team which are not in the configuration show up here.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"mode": schema.StringAttribute{
+				Description:         "Either authoritative, to remove the members which are not in the configuration, or additive, to leave them in the team. Defaults to authoritative.",
+				MarkdownDescription: "Either `authoritative`, to remove the members which are not in the configuration, or `additive`, to leave them in the team. Defaults to `authoritative`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(ModeAuthoritative),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ModeAuthoritative, ModeAdditive),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Description:         "The members of the team managed by this resource.",
+				MarkdownDescription: "The members of the team managed by this resource.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.Int64Attribute{
+							Description:         "The ID of the member.",
+							MarkdownDescription: "The ID of the member.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							Description:         "The username of the member.",
+							MarkdownDescription: "The username of the member.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							Description:         "The email of the member.",
+							MarkdownDescription: "The email of the member.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TeamMembersModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Team         types.String `tfsdk:"team"`
+	Emails       types.Set    `tfsdk:"emails"`
+	MemberIDs    types.Set    `tfsdk:"member_ids"`
+	Mode         types.String `tfsdk:"mode"`
+	Members      types.Set    `tfsdk:"members"`
+}
+
+type MemberModel struct {
+	MemberID types.Int64  `tfsdk:"member_id"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+}
+
+func MemberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"member_id": types.Int64Type,
+		"username":  types.StringType,
+		"email":     types.StringType,
+	}
+}